@@ -50,6 +50,16 @@ provider "sendgrid" {
 }
 ```
 
+Objects belonging to other subusers can be managed from the same provider block
+by setting `on_behalf_of` on each resource or data source, which takes precedence over the provider `subuser`.
+
+```hcl
+resource "sendgrid_template" "subuser_template" {
+    name         = "my-template"
+    on_behalf_of = "username"
+}
+```
+
 ### Environment variables
 
 You can provide your credentials via `SENDGRID_API_KEY`. You also can set `SENDGRID_HOST` and `SENDGRID_SUBUSER` environment variables.
@@ -65,9 +75,29 @@ $ export SENDGRID_API_KEY="SG.XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX
 $ terraform plan
 ```
 
+## Retries
+
+Requests that are rate limited are retried with a jittered exponential backoff, as well as
+idempotent requests (i.e. not creating an object) failing with a transient server error (500, 502, 503, 504).
+When SendGrid reports a rate limit reset time, the provider waits until then.
+Retries can be tuned with `max_retries` (default: 5) and `max_backoff` (default: `30s`),
+or the `SENDGRID_MAX_RETRIES` and `SENDGRID_MAX_BACKOFF` environment variables.
+
+```hcl
+provider "sendgrid" {
+    max_retries = 10
+    max_backoff = "1m"
+}
+```
+
 ## Testing
 
-Credentials must be provided via the `SENDGRID_API_KEY` environment variable in order to run acceptance tests.
+Credentials must be provided via the `SENDGRID_API_KEY` environment variable in order to run acceptance tests against a live account.
+When it is not set, acceptance tests run offline against the in-memory fake of the Sendgrid API provided by the `sdk/sendgridtest` package:
+
+```sh
+$ make testacc
+```
 
 ## Datasources/Resources reference
 {{range $k, $v := .datasource}}
@@ -61,7 +61,7 @@ func (c *Client) CreateAPIKey(ctx context.Context, req *APIKey) (*APIKey, Reques
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingAPIKey, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingAPIKey),
 		}
 	}
 
@@ -77,7 +77,7 @@ func (c *Client) ReadAPIKey(ctx context.Context, id string) (*APIKey, RequestErr
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/api_keys/"+id)
+	respBody, statusCode, err := c.Get(ctx, "GET", "/api_keys/"+id)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -85,11 +85,18 @@ func (c *Client) ReadAPIKey(ctx context.Context, id string) (*APIKey, RequestErr
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseAPIKey(respBody)
 }
 
 func (c *Client) ReadAPIKeys(ctx context.Context) ([]APIKey, RequestError) {
-	respBody, _, err := c.Get(ctx, "GET", "/api_keys")
+	respBody, statusCode, err := c.Get(ctx, "GET", "/api_keys")
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -97,6 +104,13 @@ func (c *Client) ReadAPIKeys(ctx context.Context) ([]APIKey, RequestError) {
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseAPIKeys(respBody)
 }
 
@@ -116,7 +130,7 @@ func (c *Client) UpdateAPIKey(ctx context.Context, id string, req *APIKey) (*API
 		method = rest.Patch
 	}
 
-	respBody, _, err := c.Post(ctx, method, "/api_keys/"+id, req)
+	respBody, statusCode, err := c.Post(ctx, method, "/api_keys/"+id, req)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -124,6 +138,13 @@ func (c *Client) UpdateAPIKey(ctx context.Context, id string, req *APIKey) (*API
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseAPIKey(respBody)
 }
 
@@ -147,7 +168,7 @@ func (c *Client) DeleteAPIKey(ctx context.Context, id string) (bool, *RequestErr
 	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound {
 		return false, &RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingAPIKey, statusCode, responseBody),
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingAPIKey),
 		}
 	}
 
@@ -4,18 +4,43 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/sendgrid/rest"
 	"github.com/sendgrid/sendgrid-go"
 )
 
+const (
+	// DefaultMaxRetries is the number of times a rate limited or failed request is retried by default.
+	DefaultMaxRetries = 5
+
+	// DefaultMaxBackoff is the default upper bound of the delay between two retries.
+	DefaultMaxBackoff = 30 * time.Second
+
+	minBackoff = 500 * time.Millisecond
+)
+
 // Client is a Sendgrid client.
 type Client struct {
 	apiKey     string
 	host       string
 	OnBehalfOf string
+
+	// MaxRetries is the number of times a request is retried
+	// when Sendgrid rate limits it or answers with a transient server error.
+	MaxRetries int
+
+	// MaxBackoff caps the exponential delay between two retries.
+	// The reset time reported by a rate limited response is always honored.
+	MaxBackoff time.Duration
 }
 
+type onBehalfOfKey struct{}
+
 // NewClient creates a Sendgrid Client.
 func NewClient(apiKey, host, onBehalfOf string) *Client {
 	if host == "" {
@@ -26,9 +51,139 @@ func NewClient(apiKey, host, onBehalfOf string) *Client {
 		apiKey:     apiKey,
 		host:       host,
 		OnBehalfOf: onBehalfOf,
+		MaxRetries: DefaultMaxRetries,
+		MaxBackoff: DefaultMaxBackoff,
+	}
+}
+
+// WithOnBehalfOf returns a copy of ctx making every request sent with it
+// on behalf of the given subuser, overriding the client's OnBehalfOf.
+// An empty username leaves ctx untouched.
+func WithOnBehalfOf(ctx context.Context, username string) context.Context {
+	if username == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, onBehalfOfKey{}, username)
+}
+
+// OnBehalfOfFromContext returns the subuser requests sent with ctx are made on behalf of,
+// or an empty string when they are made for the client's default account.
+func (c *Client) OnBehalfOfFromContext(ctx context.Context) string {
+	if username, ok := ctx.Value(onBehalfOfKey{}).(string); ok {
+		return username
+	}
+
+	return c.OnBehalfOf
+}
+
+func (c *Client) newRequest(ctx context.Context, method rest.Method, endpoint string) rest.Request {
+	var req rest.Request
+	if onBehalfOf := c.OnBehalfOfFromContext(ctx); onBehalfOf != "" {
+		req = sendgrid.GetRequestSubuser(c.apiKey, endpoint, c.host, onBehalfOf)
+	} else {
+		req = sendgrid.GetRequest(c.apiKey, endpoint, c.host)
+	}
+
+	req.Method = method
+
+	return req
+}
+
+// send sends req to Sendgrid, retrying it with a jittered exponential backoff
+// while it is rate limited, or fails with a transient server error if it is idempotent.
+func (c *Client) send(ctx context.Context, req rest.Request) (*rest.Response, error) {
+	for attempt := 0; ; attempt++ {
+		tflog.Debug(ctx, "sending request to sendgrid", map[string]interface{}{
+			"request": req,
+		})
+		resp, err := sendgrid.MakeRequestWithContext(ctx, req)
+		tflog.Debug(ctx, "response from sendgrid", map[string]interface{}{
+			"response": resp,
+		})
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		if !isRetryable(req.Method, resp.StatusCode) || attempt >= c.MaxRetries {
+			return resp, nil
+		}
+
+		delay := c.retryDelay(attempt, resp)
+		tflog.Warn(ctx, "retrying sendgrid request", map[string]interface{}{
+			"status_code": resp.StatusCode,
+			"attempt":     attempt + 1,
+			"delay":       delay.String(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, ctx.Err() //nolint:wrapcheck
+		case <-timer.C:
+		}
+	}
+}
+
+// isRetryable tells if a request can be sent again. A rate limited request was never processed,
+// whereas Sendgrid may have processed a request failing with a server error: only idempotent
+// ones are retried then, a POST could create the same object twice.
+func isRetryable(method rest.Method, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method rest.Method) bool {
+	switch method {
+	case rest.Get, http.MethodHead, rest.Put, rest.Patch, rest.Delete:
+		return true
+	default:
+		return false
 	}
 }
 
+// retryDelay waits until the rate limit reset time when Sendgrid reports one,
+// and otherwise backs off exponentially with jitter, up to MaxBackoff.
+func (c *Client) retryDelay(attempt int, resp *rest.Response) time.Duration {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if reset, err := strconv.ParseInt(http.Header(resp.Headers).Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			if delay := time.Until(time.Unix(reset, 0)); delay > 0 {
+				return delay
+			}
+
+			return 0
+		}
+	}
+
+	backoff := minBackoff
+	for i := 0; i < attempt && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > c.MaxBackoff {
+		backoff = c.MaxBackoff
+	}
+
+	if backoff <= 0 {
+		return 0
+	}
+
+	half := backoff / 2
+
+	return half + time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec
+}
+
 func bodyToJSON(body interface{}) ([]byte, error) {
 	if body == nil {
 		return nil, ErrBodyNotNil
@@ -44,24 +199,11 @@ func bodyToJSON(body interface{}) ([]byte, error) {
 
 // Get gets a resource from Sendgrid.
 func (c *Client) Get(ctx context.Context, method rest.Method, endpoint string) (string, int, error) {
-	var req rest.Request
-	if c.OnBehalfOf != "" {
-		req = sendgrid.GetRequestSubuser(c.apiKey, endpoint, c.host, c.OnBehalfOf)
-	} else {
-		req = sendgrid.GetRequest(c.apiKey, endpoint, c.host)
-	}
-
-	req.Method = method
+	req := c.newRequest(ctx, method, endpoint)
 
-	tflog.Debug(ctx, "sending request to sendgrid", map[string]interface{}{
-		"request": req,
-	})
-	resp, err := sendgrid.MakeRequestWithContext(ctx, req)
-	tflog.Debug(ctx, "response from sendgrid", map[string]interface{}{
-		"response": resp,
-	})
+	resp, err := c.send(ctx, req)
 	if err != nil {
-		return "", resp.StatusCode, fmt.Errorf("failed getting resource: %w", err)
+		return "", 0, fmt.Errorf("failed getting resource: %w", err)
 	}
 
 	return resp.Body, resp.StatusCode, nil
@@ -71,15 +213,7 @@ func (c *Client) Get(ctx context.Context, method rest.Method, endpoint string) (
 func (c *Client) Post(ctx context.Context, method rest.Method, endpoint string, body interface{}) (string, int, error) {
 	var err error
 
-	var req rest.Request
-
-	if c.OnBehalfOf != "" {
-		req = sendgrid.GetRequestSubuser(c.apiKey, endpoint, c.host, c.OnBehalfOf)
-	} else {
-		req = sendgrid.GetRequest(c.apiKey, endpoint, c.host)
-	}
-
-	req.Method = method
+	req := c.newRequest(ctx, method, endpoint)
 
 	if body != nil {
 		req.Body, err = bodyToJSON(body)
@@ -89,15 +223,9 @@ func (c *Client) Post(ctx context.Context, method rest.Method, endpoint string,
 		return "", 0, fmt.Errorf("failed preparing request body: %w", err)
 	}
 
-	tflog.Debug(ctx, "sending request to sendgrid", map[string]interface{}{
-		"request": req,
-	})
-	resp, err := sendgrid.MakeRequestWithContext(ctx, req)
-	tflog.Debug(ctx, "response from sendgrid", map[string]interface{}{
-		"response": resp,
-	})
+	resp, err := c.send(ctx, req)
 	if err != nil {
-		return "", resp.StatusCode, fmt.Errorf("failed posting resource: %w", err)
+		return "", 0, fmt.Errorf("failed posting resource: %w", err)
 	}
 
 	return resp.Body, resp.StatusCode, nil
@@ -0,0 +1,168 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sendgrid/rest"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestClient_OnBehalfOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		onBehalfOf string
+		ctxSubuser string
+		want       string
+	}{
+		{name: "parent account", want: ""},
+		{name: "client subuser", onBehalfOf: "client-subuser", want: "client-subuser"},
+		{name: "context subuser", ctxSubuser: "ctx-subuser", want: "ctx-subuser"},
+		{name: "context overrides client", onBehalfOf: "client-subuser", ctxSubuser: "ctx-subuser", want: "ctx-subuser"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get("On-Behalf-Of")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			c := sendgrid.NewClient("key", server.URL, tt.onBehalfOf)
+			ctx := sendgrid.WithOnBehalfOf(context.Background(), tt.ctxSubuser)
+
+			if _, _, err := c.Get(ctx, http.MethodGet, "/api_keys"); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Get() On-Behalf-Of = %q, want %q", got, tt.want)
+			}
+
+			if _, _, err := c.Post(ctx, http.MethodPost, "/api_keys", sendgrid.APIKey{Name: "key"}); err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Post() On-Behalf-Of = %q, want %q", got, tt.want)
+			}
+
+			if c.OnBehalfOf != tt.onBehalfOf {
+				t.Errorf("client OnBehalfOf mutated to %q", c.OnBehalfOf)
+			}
+		})
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statuses   []int
+		maxRetries int
+		wantStatus int
+		wantCalls  int
+	}{
+		{name: "success", method: http.MethodPost, statuses: []int{200}, maxRetries: 3, wantStatus: 200, wantCalls: 1},
+		{name: "rate limited", method: http.MethodPost, statuses: []int{429, 429, 200}, maxRetries: 3, wantStatus: 200, wantCalls: 3},
+		{name: "transient errors", method: http.MethodPut, statuses: []int{500, 502, 503, 504, 200}, maxRetries: 5, wantStatus: 200, wantCalls: 5},
+		{name: "transient errors on create", method: http.MethodPost, statuses: []int{502, 201}, maxRetries: 3, wantStatus: 502, wantCalls: 1},
+		{name: "client error", method: http.MethodPut, statuses: []int{400, 200}, maxRetries: 3, wantStatus: 400, wantCalls: 1},
+		{name: "retries exhausted", method: http.MethodPost, statuses: []int{429, 429, 429, 200}, maxRetries: 2, wantStatus: 429, wantCalls: 3},
+		{name: "retries disabled", method: http.MethodPatch, statuses: []int{503, 200}, maxRetries: 0, wantStatus: 503, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[calls]
+				calls++
+
+				if status == http.StatusTooManyRequests {
+					w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10))
+				}
+
+				w.WriteHeader(status)
+			}))
+			defer server.Close()
+
+			c := sendgrid.NewClient("key", server.URL, "")
+			c.MaxRetries = tt.maxRetries
+			c.MaxBackoff = time.Millisecond
+
+			_, statusCode, err := c.Post(context.Background(), rest.Method(tt.method), "/templates", sendgrid.Template{Name: "t"})
+			if err != nil {
+				t.Fatalf("Post(%s) error = %v", tt.method, err)
+			}
+
+			if statusCode != tt.wantStatus {
+				t.Errorf("Post(%s) status = %d, want %d", tt.method, statusCode, tt.wantStatus)
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("Post(%s) calls = %d, want %d", tt.method, calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClient_RetryHonorsRateLimitReset(t *testing.T) {
+	reset := time.Now().Add(time.Second).Truncate(time.Second).Add(time.Second)
+	calls := 0
+
+	var retriedAt time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		retriedAt = time.Now()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+	c.MaxBackoff = time.Millisecond
+
+	if _, _, err := c.Get(context.Background(), http.MethodGet, "/api_keys"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if retriedAt.Before(reset) {
+		t.Errorf("Get() retried at %s, before the rate limit reset at %s", retriedAt, reset)
+	}
+}
+
+func TestClient_RetryStopsOnContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+	c.MaxBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := c.Get(ctx, http.MethodGet, "/api_keys"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
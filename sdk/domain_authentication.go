@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type DomainAuthenticationDNS struct {
@@ -40,6 +41,19 @@ type DomainAuthentication struct { //nolint:maligned
 	DNS                DomainAuthenticationDNS `json:"dns,omitempty"`
 }
 
+// DomainAuthenticationValidation is the outcome of the validation of a DomainAuthentication.
+// ValidationResults is keyed by the DNS record, e.g. mail_cname or dkim1.
+type DomainAuthenticationValidation struct {
+	ID                int32                       `json:"id"`
+	Valid             bool                        `json:"valid"`
+	ValidationResults map[string]ValidationResult `json:"validation_results"` //nolint:tagliatelle
+}
+
+// subuserAssociation is the body associating a domain authentication or a link branding with a subuser.
+type subuserAssociation struct {
+	Username string `json:"username"`
+}
+
 func ParseDomainAuthentication(respBody string) (*DomainAuthentication, RequestError) {
 	var body DomainAuthentication
 	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
@@ -89,7 +103,7 @@ func (c *Client) CreateDomainAuthentication(
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingDomainAuthentication, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingDomainAuthentication),
 		}
 	}
 
@@ -105,7 +119,7 @@ func (c *Client) ReadDomainAuthentication(ctx context.Context, id string) (*Doma
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/whitelabel/domains/"+id)
+	respBody, statusCode, err := c.Get(ctx, "GET", "/whitelabel/domains/"+id)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -113,6 +127,13 @@ func (c *Client) ReadDomainAuthentication(ctx context.Context, id string) (*Doma
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return ParseDomainAuthentication(respBody)
 }
 
@@ -134,7 +155,7 @@ func (c *Client) UpdateDomainAuthentication(
 	t.IsDefault = isDefault
 	t.CustomSPF = customSPF
 
-	respBody, _, err := c.Post(ctx, "PATCH", "/whitelabel/domains/"+id, t)
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/whitelabel/domains/"+id, t)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -142,26 +163,50 @@ func (c *Client) UpdateDomainAuthentication(
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return ParseDomainAuthentication(respBody)
 }
 
-func (c *Client) ValidateDomainAuthentication(ctx context.Context, id string) RequestError {
+// ValidateDomainAuthentication asks Sendgrid to check the DNS records of a DomainAuthentication
+// and returns the outcome.
+func (c *Client) ValidateDomainAuthentication(ctx context.Context, id string) (*DomainAuthenticationValidation, RequestError) {
 	if id == "" {
-		return RequestError{
+		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        ErrDomainAuthenticationIDRequired,
 		}
 	}
 
-	_, statusCode, err := c.Post(ctx, "POST", "/whitelabel/domains/"+id+"/validate", nil)
-	if err != nil || statusCode != 200 {
-		return RequestError{
+	respBody, statusCode, err := c.Post(ctx, "POST", "/whitelabel/domains/"+id+"/validate", nil)
+	if err != nil {
+		return nil, RequestError{
 			StatusCode: statusCode,
 			Err:        err,
 		}
 	}
 
-	return RequestError{StatusCode: http.StatusOK, Err: nil}
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	var body DomainAuthenticationValidation
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing domain authentication validation: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
 }
 
 // DeleteDomainAuthentication deletes an DomainAuthentication.
@@ -184,8 +229,98 @@ func (c *Client) DeleteDomainAuthentication(ctx context.Context, id string) (boo
 	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
 		return false, RequestError{
 			StatusCode: statusCode,
-			Err: fmt.Errorf("%w, status: %d, response: %s",
-				ErrFailedDeletingDomainAuthentication, statusCode, responseBody),
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingDomainAuthentication),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AssociateDomainAuthenticationWithSubuser shares a DomainAuthentication of the parent account with a subuser.
+// A subuser can only be associated with one DomainAuthentication at a time.
+func (c *Client) AssociateDomainAuthenticationWithSubuser(ctx context.Context, id, username string) RequestError {
+	if id == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrDomainAuthenticationIDRequired,
+		}
+	}
+
+	if username == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/whitelabel/domains/"+id+"/subuser", subuserAssociation{
+		Username: username,
+	})
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedAssociatingDomainAuthentication),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadSubuserDomainAuthentication retrieves the DomainAuthentication associated with a subuser and returns it.
+func (c *Client) ReadSubuserDomainAuthentication(ctx context.Context, username string) (*DomainAuthentication, RequestError) {
+	if username == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/whitelabel/domains/subuser?username="+url.QueryEscape(username))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return ParseDomainAuthentication(respBody)
+}
+
+// DisassociateSubuserDomainAuthentication stops sharing its DomainAuthentication with a subuser.
+func (c *Client) DisassociateSubuserDomainAuthentication(ctx context.Context, username string) (bool, RequestError) {
+	if username == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "DELETE", "/whitelabel/domains/subuser?username="+url.QueryEscape(username))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedDisassociatingDomainAuthentication),
 		}
 	}
 
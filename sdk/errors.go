@@ -1,16 +1,17 @@
 package sendgrid
 
 import (
-	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
 )
 
 var (
+	// ErrNotFound error returned by every Read function when the object doesn't exist (anymore) in Sendgrid.
+	ErrNotFound = errors.New("not found")
+
 	// ErrBodyNotNil low error displayed when the prepared body for a POST call
 	// to the API is nil.
 	ErrBodyNotNil = errors.New("body must not be nil")
@@ -117,6 +118,145 @@ var (
 
 	// ErrFailedUpdatingSSOCertificate error displayed when an SSO certificate update request fails.
 	ErrFailedUpdatingSSOCertificate = errors.New("failed to update SSO certificate")
+
+	// ErrSenderIdentityIDRequired error displayed when a sender identity ID wasn't specified.
+	ErrSenderIdentityIDRequired = errors.New("a sender identity ID is required")
+
+	// ErrFailedCreatingSenderIdentity error displayed when the provider can not create a sender identity.
+	ErrFailedCreatingSenderIdentity = errors.New("failed creating sender identity")
+
+	// ErrFailedUpdatingSenderIdentity error displayed when the provider can not update a sender identity.
+	ErrFailedUpdatingSenderIdentity = errors.New("failed updating sender identity")
+
+	// ErrFailedDeletingSenderIdentity error displayed when the provider can not delete a sender identity.
+	ErrFailedDeletingSenderIdentity = errors.New("failed deleting sender identity")
+
+	// ErrTeammateEmailRequired error displayed when a teammate email wasn't specified.
+	ErrTeammateEmailRequired = errors.New("a teammate email is required")
+
+	// ErrTeammateUsernameRequired error displayed when a teammate username wasn't specified.
+	ErrTeammateUsernameRequired = errors.New("a teammate username is required")
+
+	// ErrTeammateTokenRequired error displayed when the token of a teammate invitation wasn't specified.
+	ErrTeammateTokenRequired = errors.New("a teammate invitation token is required")
+
+	// ErrFailedCreatingTeammate error displayed when the provider can not create a teammate.
+	ErrFailedCreatingTeammate = errors.New("failed creating teammate")
+
+	// ErrFailedUpdatingTeammate error displayed when the provider can not update a teammate.
+	ErrFailedUpdatingTeammate = errors.New("failed updating teammate")
+
+	// ErrFailedDeletingTeammate error displayed when the provider can not delete a teammate.
+	ErrFailedDeletingTeammate = errors.New("failed deleting teammate")
+
+	// ErrIPAddressRequired error displayed when an IP address wasn't specified.
+	ErrIPAddressRequired = errors.New("an IP address is required")
+
+	// ErrIPPoolNameRequired error displayed when an IP pool name wasn't specified.
+	ErrIPPoolNameRequired = errors.New("an IP pool name is required")
+
+	// ErrFailedCreatingIPPool error displayed when the provider can not create an IP pool.
+	ErrFailedCreatingIPPool = errors.New("failed creating IP pool")
+
+	// ErrFailedUpdatingIPPool error displayed when the provider can not update an IP pool.
+	ErrFailedUpdatingIPPool = errors.New("failed updating IP pool")
+
+	// ErrFailedDeletingIPPool error displayed when the provider can not delete an IP pool.
+	ErrFailedDeletingIPPool = errors.New("failed deleting IP pool")
+
+	// ErrFailedAddingIPToPool error displayed when the provider can not add an IP to an IP pool.
+	ErrFailedAddingIPToPool = errors.New("failed adding IP to pool")
+
+	// ErrFailedRemovingIPFromPool error displayed when the provider can not remove an IP from an IP pool.
+	ErrFailedRemovingIPFromPool = errors.New("failed removing IP from pool")
+
+	// ErrFailedStartingIPWarmup error displayed when the provider can not start warming up an IP.
+	ErrFailedStartingIPWarmup = errors.New("failed starting IP warmup")
+
+	// ErrFailedStoppingIPWarmup error displayed when the provider can not stop warming up an IP.
+	ErrFailedStoppingIPWarmup = errors.New("failed stopping IP warmup")
+
+	// ErrReverseDNSIDRequired error displayed when a reverse DNS ID wasn't specified.
+	ErrReverseDNSIDRequired = errors.New("a reverse DNS ID is required")
+
+	// ErrFailedCreatingReverseDNS error displayed when the provider can not create a reverse DNS.
+	ErrFailedCreatingReverseDNS = errors.New("failed creating reverse DNS")
+
+	// ErrFailedDeletingReverseDNS error displayed when the provider can not delete a reverse DNS.
+	ErrFailedDeletingReverseDNS = errors.New("failed deleting reverse DNS")
+
+	// ErrFailedAssociatingDomainAuthentication error displayed when the provider can not associate
+	// a domain authentication with a subuser.
+	ErrFailedAssociatingDomainAuthentication = errors.New("failed associating domain authentication with subuser")
+
+	// ErrFailedDisassociatingDomainAuthentication error displayed when the provider can not disassociate
+	// a domain authentication from a subuser.
+	ErrFailedDisassociatingDomainAuthentication = errors.New("failed disassociating domain authentication from subuser")
+
+	// ErrFailedAssociatingLinkBranding error displayed when the provider can not associate
+	// a link branding with a subuser.
+	ErrFailedAssociatingLinkBranding = errors.New("failed associating link branding with subuser")
+
+	// ErrFailedDisassociatingLinkBranding error displayed when the provider can not disassociate
+	// a link branding from a subuser.
+	ErrFailedDisassociatingLinkBranding = errors.New("failed disassociating link branding from subuser")
+
+	// ErrEventWebhookIDRequired error displayed when an event webhook ID wasn't specified.
+	ErrEventWebhookIDRequired = errors.New("an event webhook ID is required")
+
+	// ErrFailedCreatingEventWebhook error displayed when the provider can not create an event webhook.
+	ErrFailedCreatingEventWebhook = errors.New("failed creating event webhook")
+
+	// ErrFailedDeletingEventWebhook error displayed when the provider can not delete an event webhook.
+	ErrFailedDeletingEventWebhook = errors.New("failed deleting event webhook")
+
+	// ErrInvalidEventWebhookPublicKey error displayed when the public key of a signed event webhook can't be parsed.
+	ErrInvalidEventWebhookPublicKey = errors.New("invalid event webhook public key")
+
+	// ErrInvalidEventWebhookTimestamp error displayed when the timestamp of signed events isn't a Unix time.
+	ErrInvalidEventWebhookTimestamp = errors.New("invalid event webhook timestamp")
+
+	// ErrEventWebhookTimestampOutsideReplayWindow error displayed when signed events are too old, or too far in the future.
+	ErrEventWebhookTimestampOutsideReplayWindow = errors.New("event webhook timestamp outside of the replay window")
+
+	// ErrInvalidEventWebhookSignature error displayed when the signature of events doesn't match their payload.
+	ErrInvalidEventWebhookSignature = errors.New("invalid event webhook signature")
+
+	// ErrFailedTestingEventWebhook error displayed when Sendgrid can not deliver a test event to an event webhook.
+	ErrFailedTestingEventWebhook = errors.New("failed testing event webhook")
+
+	// ErrFailedAddingSuppressions error displayed when the provider can not unsubscribe recipients.
+	ErrFailedAddingSuppressions = errors.New("failed adding suppressions")
+
+	// ErrFailedDeletingSuppression error displayed when the provider can not resubscribe a recipient.
+	ErrFailedDeletingSuppression = errors.New("failed deleting suppression")
+
+	// ErrMailSettingNameRequired error displayed when the name of a mail setting wasn't specified.
+	ErrMailSettingNameRequired = errors.New("a mail setting name is required")
+
+	// ErrFailedUpdatingMailSetting error displayed when the provider can not update a mail setting.
+	ErrFailedUpdatingMailSetting = errors.New("failed updating mail setting")
+
+	// ErrTrackingSettingNameRequired error displayed when the name of a tracking setting wasn't specified.
+	ErrTrackingSettingNameRequired = errors.New("a tracking setting name is required")
+
+	// ErrFailedUpdatingTrackingSetting error displayed when the provider can not update a tracking setting.
+	ErrFailedUpdatingTrackingSetting = errors.New("failed updating tracking setting")
+
+	// ErrSubuserCreditsTypeRequired error displayed when the type of the credit allocation of a subuser wasn't specified.
+	ErrSubuserCreditsTypeRequired = errors.New("a credit allocation type is required")
+
+	// ErrFailedUpdatingSubuserCredits error displayed when the provider can not update the credit allocation of a subuser.
+	ErrFailedUpdatingSubuserCredits = errors.New("failed updating subuser credits")
+
+	// ErrFailedCreatingSubuserMonitor error displayed when the provider can not create the monitor of a subuser.
+	ErrFailedCreatingSubuserMonitor = errors.New("failed creating subuser monitor")
+
+	// ErrFailedUpdatingSubuserMonitor error displayed when the provider can not update the monitor of a subuser.
+	ErrFailedUpdatingSubuserMonitor = errors.New("failed updating subuser monitor")
+
+	// ErrFailedDeletingSubuserMonitor error displayed when the provider can not delete the monitor of a subuser.
+	ErrFailedDeletingSubuserMonitor = errors.New("failed deleting subuser monitor")
 )
 
 // RequestError struct permits to embed to return the statucode and the error to the parent function.
@@ -129,38 +269,77 @@ func (err *RequestError) Error() string {
 	return err.Err.Error()
 }
 
-type subUserError struct {
-	Field   string `json:"field,omitempty"`
-	Message string `json:"message,omitempty"`
+func (err *RequestError) Unwrap() error {
+	return err.Err
+}
+
+// APIFieldError is a single error reported by the Sendgrid API.
+// Field is empty when the error isn't about a specific field of the request.
+type APIFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	ErrorID string `json:"error_id,omitempty"` //nolint:tagliatelle
+}
+
+// APIError is returned when the Sendgrid API answers with an unsuccessful status code.
+// Err is the sentinel error describing the failed operation, if any, so that errors.Is keeps working.
+type APIError struct {
+	StatusCode int
+	Errors     []APIFieldError
+	Err        error
+
+	body string
 }
 
-type subUserErrors struct {
-	Errors []subUserError `json:"errors,omitempty"`
+func (err *APIError) Error() string {
+	msg := fmt.Sprintf("status: %d", err.StatusCode)
+	if err.Err != nil {
+		msg = err.Err.Error() + ", " + msg
+	}
+
+	if len(err.Errors) == 0 {
+		if err.body != "" {
+			msg += ", response: " + err.body
+		}
+
+		return msg
+	}
+
+	messages := make([]string, 0, len(err.Errors))
+
+	for _, e := range err.Errors {
+		if e.Field != "" {
+			messages = append(messages, e.Field+": "+e.Message)
+		} else {
+			messages = append(messages, e.Message)
+		}
+	}
+
+	return msg + ", errors: " + strings.Join(messages, "; ")
+}
+
+func (err *APIError) Unwrap() error {
+	return err.Err
 }
 
-// RetryOnRateLimit management of RequestErrors, and launch a retry if needed.
-func RetryOnRateLimit(
-	ctx context.Context, d *schema.ResourceData, f func() (interface{}, RequestError)) (interface{}, error) {
-	var resp interface{}
-
-	err := resource.RetryContext(
-		ctx,
-		d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			var requestErr RequestError
-			resp, requestErr = f()
-			if requestErr.Err != nil {
-				if requestErr.StatusCode == http.StatusTooManyRequests {
-					return resource.RetryableError(requestErr.Err)
-				}
-
-				return resource.NonRetryableError(requestErr.Err)
-			}
-
-			return nil
-		})
-	if err != nil {
-		return resp, fmt.Errorf("request failed: %w", err)
+// Is makes errors.Is(err, ErrNotFound) true for a 404 response.
+func (err *APIError) Is(target error) bool {
+	return target == ErrNotFound && err.StatusCode == http.StatusNotFound
+}
+
+// newAPIError parses the errors payload of an unsuccessful response.
+func newAPIError(statusCode int, respBody string, err error) *APIError {
+	var body struct {
+		Errors []APIFieldError `json:"errors"`
 	}
 
-	return resp, nil
+	// Not every response carries an errors payload, the raw body is kept for those.
+	_ = json.Unmarshal([]byte(respBody), &body)
+
+	return &APIError{
+		StatusCode: statusCode,
+		Errors:     body.Errors,
+		Err:        err,
+		body:       respBody,
+	}
 }
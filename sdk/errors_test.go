@@ -0,0 +1,141 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+	"github.com/taharah/terraform-provider-sendgrid/sdk/sendgridtest"
+)
+
+func TestAPIError(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	c := sendgrid.NewClient(sendgridtest.APIKey, server.Host(), "")
+
+	if _, err := c.CreateSubuser(ctx, "subuser", "subuser@example.org", "password", []string{"127.0.0.1"}); err.Err != nil {
+		t.Fatalf("CreateSubuser() error = %v", err.Err)
+	}
+
+	tests := []struct {
+		name       string
+		call       func() error
+		sentinel   error
+		wantStatus int
+		wantField  string
+	}{
+		{
+			name: "field error",
+			call: func() error {
+				_, err := c.CreateSubuser(ctx, "subuser", "subuser@example.org", "password", []string{"127.0.0.1"})
+
+				return err.Err
+			},
+			sentinel:   sendgrid.ErrFailedCreatingSubUser,
+			wantStatus: http.StatusBadRequest,
+			wantField:  "username",
+		},
+		{
+			name: "not found",
+			call: func() error {
+				_, err := c.ReadAPIKey(ctx, "missing")
+
+				return err.Err
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "wrapped in a request error",
+			call: func() error {
+				_, err := c.ReadTemplate(ctx, "missing")
+
+				return err
+			},
+			sentinel:   sendgrid.ErrFailedGettingTemplate,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+
+			var apiErr *sendgrid.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error = %v, want an APIError", err)
+			}
+
+			if apiErr.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.wantStatus)
+			}
+
+			if len(apiErr.Errors) != 1 || apiErr.Errors[0].Field != tt.wantField || apiErr.Errors[0].Message == "" {
+				t.Errorf("Errors = %+v, want a single error on field %q", apiErr.Errors, tt.wantField)
+			}
+
+			if tt.sentinel != nil && !errors.Is(err, tt.sentinel) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.sentinel)
+			}
+		})
+	}
+}
+
+func TestErrNotFound(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	c := sendgrid.NewClient(sendgridtest.APIKey, server.Host(), "")
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "api key",
+			call: func() error {
+				_, err := c.ReadAPIKey(ctx, "missing")
+
+				return err.Err
+			},
+		},
+		{
+			name: "subuser",
+			call: func() error {
+				_, err := c.ReadSubUser(ctx, "missing")
+
+				return err.Err
+			},
+		},
+		{
+			name: "template version",
+			call: func() error {
+				_, err := c.ReadTemplateVersion(ctx, "missing", "missing")
+
+				return err
+			},
+		},
+		{
+			name: "unsubscribe group",
+			call: func() error {
+				_, err := c.ReadUnsubscribeGroup(ctx, "0")
+
+				return err.Err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, sendgrid.ErrNotFound) {
+				t.Errorf("error = %v, want %v", err, sendgrid.ErrNotFound)
+			}
+		})
+	}
+}
@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // EventWebhook is a Sendgrid event webhook settings.
+// An account can have several event webhooks, each identified by its ID.
 type EventWebhook struct { //nolint:maligned
+	ID                string `json:"id,omitempty"`
+	FriendlyName      string `json:"friendly_name"` //nolint:tagliatelle
 	Enabled           bool   `json:"enabled"`
 	URL               string `json:"url,omitempty"`
 	GroupResubscribe  bool   `json:"group_resubscribe"` //nolint:tagliatelle
@@ -28,10 +32,20 @@ type EventWebhook struct { //nolint:maligned
 }
 
 type EventWebhookSigning struct {
+	ID        string `json:"id,omitempty"`
 	Enabled   bool   `json:"enabled"`
 	PublicKey string `json:"public_key"` //nolint:tagliatelle
 }
 
+// eventWebhookTest is the event webhook settings a test event is posted with.
+type eventWebhookTest struct {
+	ID                string `json:"id,omitempty"`
+	URL               string `json:"url"`
+	OAuthClientID     string `json:"oauth_client_id,omitempty"`     //nolint:tagliatelle
+	OAuthClientSecret string `json:"oauth_client_secret,omitempty"` //nolint:tagliatelle
+	OAuthTokenURL     string `json:"oauth_token_url,omitempty"`     //nolint:tagliatelle
+}
+
 func parseEventWebhook(respBody string) (*EventWebhook, RequestError) {
 	var body EventWebhook
 	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
@@ -56,71 +70,65 @@ func parseEventWebhookSigning(respBody string) (*EventWebhookSigning, RequestErr
 	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
 }
 
+// ReadEventWebhook retrieves the legacy event webhook of the account, i.e. its oldest one, and returns it.
+func (c *Client) ReadEventWebhook(ctx context.Context) (*EventWebhook, RequestError) {
+	respBody, statusCode, err := c.Get(ctx, "GET", "/user/webhooks/event/settings")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseEventWebhook(respBody)
+}
+
 // CreateEventWebhook creates an EventWebhook and returns it.
-func (c *Client) PatchEventWebhook(
-	ctx context.Context,
-	enabled bool,
-	url string,
-	groupResubscribe bool,
-	delivered bool,
-	groupUnsubscribe bool,
-	spamReport bool,
-	bounce bool,
-	deferred bool,
-	unsubscribe bool,
-	processed bool,
-	open bool,
-	click bool,
-	dropped bool,
-	oauthClientID string,
-	oauthClientSecret string,
-	oauthTokenURL string,
-) (*EventWebhook, RequestError) {
-	if url == "" {
+func (c *Client) CreateEventWebhook(ctx context.Context, webhook EventWebhook) (*EventWebhook, RequestError) {
+	if webhook.URL == "" {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        ErrURLRequired,
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings", EventWebhook{
-		Enabled:           enabled,
-		URL:               url,
-		GroupResubscribe:  groupResubscribe,
-		Delivered:         delivered,
-		GroupUnsubscribe:  groupUnsubscribe,
-		SpamReport:        spamReport,
-		Bounce:            bounce,
-		Deferred:          deferred,
-		Unsubscribe:       unsubscribe,
-		Processed:         processed,
-		Open:              open,
-		Click:             click,
-		Dropped:           dropped,
-		OAuthClientID:     oauthClientID,
-		OAuthClientSecret: oauthClientSecret,
-		OAuthTokenURL:     oauthTokenURL,
-	})
+	webhook.ID = ""
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/user/webhooks/event/settings", webhook)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
-			Err:        fmt.Errorf("failed patching event webhook: %w", err),
+			Err:        fmt.Errorf("failed creating event webhook: %w", err),
 		}
 	}
 
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedPatchingEventWebhook, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingEventWebhook),
 		}
 	}
 
 	return parseEventWebhook(respBody)
 }
 
-// ReadEventWebhook retrieves an EventWebhook and returns it.
-func (c *Client) ReadEventWebhook(ctx context.Context) (*EventWebhook, RequestError) {
-	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/event/settings")
+// ReadEventWebhookByID retrieves an EventWebhook and returns it.
+func (c *Client) ReadEventWebhookByID(ctx context.Context, id string) (*EventWebhook, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/"+url.PathEscape(id))
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -128,11 +136,96 @@ func (c *Client) ReadEventWebhook(ctx context.Context) (*EventWebhook, RequestEr
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseEventWebhook(respBody)
+}
+
+// UpdateEventWebhook edits an EventWebhook and returns it.
+func (c *Client) UpdateEventWebhook(ctx context.Context, id string, webhook EventWebhook) (*EventWebhook, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	if webhook.URL == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrURLRequired,
+		}
+	}
+
+	webhook.ID = ""
+
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings/"+url.PathEscape(id), webhook)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed patching event webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedPatchingEventWebhook),
+		}
+	}
+
 	return parseEventWebhook(respBody)
 }
 
-func (c *Client) ConfigureEventWebhookSigning(ctx context.Context, enabled bool) (*EventWebhookSigning, RequestError) {
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings/signed", EventWebhookSigning{
+// DeleteEventWebhook deletes an EventWebhook.
+func (c *Client) DeleteEventWebhook(ctx context.Context, id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "DELETE", "/user/webhooks/event/settings/"+url.PathEscape(id))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedDeletingEventWebhook),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ConfigureEventWebhookSigning enables or disables the signature of the events an EventWebhook posts.
+// Enabling it generates a new key pair, whose public key is returned.
+func (c *Client) ConfigureEventWebhookSigning(
+	ctx context.Context,
+	id string,
+	enabled bool,
+) (*EventWebhookSigning, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	endpoint := "/user/webhooks/event/settings/signed/" + url.PathEscape(id)
+
+	respBody, statusCode, err := c.Post(ctx, "PATCH", endpoint, EventWebhookSigning{
 		Enabled: enabled,
 	})
 	if err != nil {
@@ -145,15 +238,23 @@ func (c *Client) ConfigureEventWebhookSigning(ctx context.Context, enabled bool)
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedPatchingEventWebhook, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedPatchingEventWebhook),
 		}
 	}
 
 	return parseEventWebhookSigning(respBody)
 }
 
-func (c *Client) ReadEventWebhookSigning(ctx context.Context) (*EventWebhookSigning, RequestError) {
-	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/signed")
+// ReadEventWebhookSigning retrieves the signing settings of an EventWebhook and returns them.
+func (c *Client) ReadEventWebhookSigning(ctx context.Context, id string) (*EventWebhookSigning, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/signed/"+url.PathEscape(id))
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -161,5 +262,47 @@ func (c *Client) ReadEventWebhookSigning(ctx context.Context) (*EventWebhookSign
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseEventWebhookSigning(respBody)
 }
+
+// TestEventWebhook asks Sendgrid to post a test event to the URL of webhook, authenticating with its
+// OAuth settings if any. The OAuth client secret of webhook can be omitted when its ID is set, Sendgrid
+// then uses the one it stores for the event webhook. An error is returned if the event can't be delivered.
+func (c *Client) TestEventWebhook(ctx context.Context, webhook EventWebhook) RequestError {
+	if webhook.URL == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrURLRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/user/webhooks/event/test", eventWebhookTest{
+		ID:                webhook.ID,
+		URL:               webhook.URL,
+		OAuthClientID:     webhook.OAuthClientID,
+		OAuthClientSecret: webhook.OAuthClientSecret,
+		OAuthTokenURL:     webhook.OAuthTokenURL,
+	})
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed testing event webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedTestingEventWebhook),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
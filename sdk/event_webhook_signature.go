@@ -0,0 +1,76 @@
+package sendgrid
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// EventWebhookSignatureHeader is the header of the signature of the events posted by a signed EventWebhook.
+	EventWebhookSignatureHeader = "X-Twilio-Email-Event-Webhook-Signature"
+
+	// EventWebhookTimestampHeader is the header of the timestamp of the events posted by a signed EventWebhook.
+	EventWebhookTimestampHeader = "X-Twilio-Email-Event-Webhook-Timestamp"
+
+	// EventWebhookReplayWindow is how far the timestamp of signed events may be from the current time.
+	// Older events are rejected, so that a captured request can't be replayed later on.
+	EventWebhookReplayWindow = 5 * time.Minute
+)
+
+// VerifyEventWebhookSignature checks that payload, the body of a request posted by a signed EventWebhook,
+// was signed by Sendgrid with the private key matching publicKey, as returned by ReadEventWebhookSigning.
+// signature and timestamp are the values of the EventWebhookSignatureHeader and EventWebhookTimestampHeader
+// headers of the request. The timestamp must be within EventWebhookReplayWindow of the current time.
+func VerifyEventWebhookSignature(publicKey string, payload []byte, signature, timestamp string) error {
+	key, err := parseEventWebhookPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidEventWebhookTimestamp, timestamp)
+	}
+
+	if age := time.Since(time.Unix(seconds, 0)); age > EventWebhookReplayWindow || age < -EventWebhookReplayWindow {
+		return fmt.Errorf("%w: %s", ErrEventWebhookTimestampOutsideReplayWindow, timestamp)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEventWebhookSignature, err)
+	}
+
+	// Sendgrid signs the timestamp followed by the payload.
+	hash := sha256.Sum256(append([]byte(timestamp), payload...))
+
+	if !ecdsa.VerifyASN1(key, hash[:], sig) {
+		return ErrInvalidEventWebhookSignature
+	}
+
+	return nil
+}
+
+func parseEventWebhookPublicKey(publicKey string) (*ecdsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEventWebhookPublicKey, err)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEventWebhookPublicKey, err)
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: not an ECDSA key", ErrInvalidEventWebhookPublicKey)
+	}
+
+	return ecdsaKey, nil
+}
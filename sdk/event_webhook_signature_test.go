@@ -0,0 +1,168 @@
+package sendgrid_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func newEventWebhookKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key, base64.StdEncoding.EncodeToString(der)
+}
+
+// postSignedEvents posts payload to url, signed with key like Sendgrid does.
+func postSignedEvents(t *testing.T, url string, key *ecdsa.PrivateKey, payload []byte, timestamp string) int {
+	t.Helper()
+
+	hash := sha256.Sum256(append([]byte(timestamp), payload...))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set(sendgrid.EventWebhookSignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	req.Header.Set(sendgrid.EventWebhookTimestampHeader, timestamp)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestVerifyEventWebhookSignature(t *testing.T) { //nolint:funlen
+	key, publicKey := newEventWebhookKey(t)
+	otherKey, _ := newEventWebhookKey(t)
+	payload := []byte(`[{"email":"example@test.com","event":"processed"}]`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	tests := []struct {
+		name      string
+		publicKey string
+		signer    *ecdsa.PrivateKey
+		body      []byte
+		timestamp string
+		want      error
+	}{
+		{
+			name:      "valid signature",
+			publicKey: publicKey,
+			signer:    key,
+			timestamp: now,
+		},
+		{
+			name:      "signed with another key",
+			publicKey: publicKey,
+			signer:    otherKey,
+			timestamp: now,
+			want:      sendgrid.ErrInvalidEventWebhookSignature,
+		},
+		{
+			name:      "tampered payload",
+			publicKey: publicKey,
+			signer:    key,
+			body:      []byte(`[{"email":"attacker@test.com","event":"processed"}]`),
+			timestamp: now,
+			want:      sendgrid.ErrInvalidEventWebhookSignature,
+		},
+		{
+			name:      "replayed events",
+			publicKey: publicKey,
+			signer:    key,
+			timestamp: strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10),
+			want:      sendgrid.ErrEventWebhookTimestampOutsideReplayWindow,
+		},
+		{
+			name:      "invalid timestamp",
+			publicKey: publicKey,
+			signer:    key,
+			timestamp: "yesterday",
+			want:      sendgrid.ErrInvalidEventWebhookTimestamp,
+		},
+		{
+			name:      "invalid public key",
+			publicKey: "not-a-key",
+			signer:    key,
+			timestamp: now,
+			want:      sendgrid.ErrInvalidEventWebhookPublicKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var got error
+
+			// The receiver of the events, standing in for the endpoint of the event webhook.
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+
+				if tt.body != nil {
+					body = tt.body
+				}
+
+				got = sendgrid.VerifyEventWebhookSignature(tt.publicKey, body,
+					r.Header.Get(sendgrid.EventWebhookSignatureHeader), r.Header.Get(sendgrid.EventWebhookTimestampHeader))
+				if got != nil {
+					w.WriteHeader(http.StatusForbidden)
+
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			status := postSignedEvents(t, server.URL, tt.signer, payload, tt.timestamp)
+
+			if !errors.Is(got, tt.want) {
+				t.Errorf("VerifyEventWebhookSignature() = %v, want %v", got, tt.want)
+			}
+
+			wantStatus := http.StatusOK
+			if tt.want != nil {
+				wantStatus = http.StatusForbidden
+			}
+
+			if status != wantStatus {
+				t.Errorf("receiver answered %d, want %d", status, wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ipsPageSize is the largest page of IPs Sendgrid returns at once.
+const ipsPageSize = 500
+
+// IP is an IP address of the account.
+type IP struct {
+	IP           string   `json:"ip"`
+	Subusers     []string `json:"subusers"`
+	RDNS         string   `json:"rdns,omitempty"`
+	Pools        []string `json:"pools"`
+	Warmup       bool     `json:"warmup"`
+	StartDate    int64    `json:"start_date"` //nolint:tagliatelle
+	Whitelabeled bool     `json:"whitelabeled"`
+	AssignedAt   int64    `json:"assigned_at"` //nolint:tagliatelle
+}
+
+// ReadIPs retrieves all the IPs of the account.
+func (c *Client) ReadIPs(ctx context.Context) ([]IP, RequestError) {
+	return c.readIPs(ctx, url.Values{})
+}
+
+// ReadSubuserIPs retrieves the IPs of the account assigned to a subuser.
+func (c *Client) ReadSubuserIPs(ctx context.Context, username string) ([]IP, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	return c.readIPs(ctx, url.Values{"subuser": {username}})
+}
+
+func (c *Client) readIPs(ctx context.Context, query url.Values) ([]IP, RequestError) {
+	var result []IP
+
+	query.Set("limit", strconv.Itoa(ipsPageSize))
+
+	for offset := 0; ; offset += ipsPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+		endpoint := "/ips?" + query.Encode()
+
+		respBody, statusCode, err := c.Get(ctx, "GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode: statusCode,
+				Err:        newAPIError(statusCode, respBody, nil),
+			}
+		}
+
+		var ips []IP
+		if err := json.Unmarshal([]byte(respBody), &ips); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing IPs: %w", err),
+			}
+		}
+
+		result = append(result, ips...)
+
+		if len(ips) < ipsPageSize {
+			return result, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
+
+// ReadIP retrieves an IP of the account and returns it.
+func (c *Client) ReadIP(ctx context.Context, ip string) (*IP, RequestError) {
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/ips/"+url.PathEscape(ip))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	var body IP
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
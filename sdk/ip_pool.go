@@ -0,0 +1,225 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IPPool is a named group of IPs, which emails can be sent from.
+type IPPool struct {
+	Name string         `json:"name"`
+	IPs  []IPPoolMember `json:"ips,omitempty"`
+}
+
+// IPPoolMember is an IP belonging to an IPPool.
+type IPPoolMember struct {
+	IP        string `json:"ip"`
+	StartDate int64  `json:"start_date"` //nolint:tagliatelle
+	Warmup    bool   `json:"warmup"`
+}
+
+type ipPoolMembers struct {
+	PoolName string         `json:"pool_name"` //nolint:tagliatelle
+	IPs      []IPPoolMember `json:"ips"`
+}
+
+func parseIPPool(respBody string) (*IPPool, RequestError) {
+	var body IPPool
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP pool: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateIPPool creates an IPPool and returns it.
+func (c *Client) CreateIPPool(ctx context.Context, name string) (*IPPool, RequestError) {
+	if name == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/ips/pools", IPPool{Name: name})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating IP pool: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingIPPool),
+		}
+	}
+
+	return parseIPPool(respBody)
+}
+
+// ReadIPPool retrieves an IPPool, including its IPs, and returns it.
+func (c *Client) ReadIPPool(ctx context.Context, name string) (*IPPool, RequestError) {
+	if name == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/ips/pools/"+url.PathEscape(name))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	var body ipPoolMembers
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP pool: %w", err),
+		}
+	}
+
+	return &IPPool{Name: body.PoolName, IPs: body.IPs}, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateIPPool renames an IPPool and returns it.
+func (c *Client) UpdateIPPool(ctx context.Context, name, newName string) (*IPPool, RequestError) {
+	if name == "" || newName == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "PUT", "/ips/pools/"+url.PathEscape(name), IPPool{Name: newName})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedUpdatingIPPool),
+		}
+	}
+
+	return parseIPPool(respBody)
+}
+
+// DeleteIPPool deletes an IPPool. Its IPs stay in the account.
+func (c *Client) DeleteIPPool(ctx context.Context, name string) (bool, RequestError) {
+	if name == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/ips/pools/"+url.PathEscape(name))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingIPPool),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AddIPToPool adds an IP of the account to an IPPool.
+func (c *Client) AddIPToPool(ctx context.Context, name, ip string) RequestError {
+	if name == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	if ip == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/ips/pools/"+url.PathEscape(name)+"/ips", IPPoolMember{IP: ip})
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedAddingIPToPool),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// RemoveIPFromPool removes an IP from an IPPool.
+func (c *Client) RemoveIPFromPool(ctx context.Context, name, ip string) (bool, RequestError) {
+	if name == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	if ip == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	endpoint := "/ips/pools/" + url.PathEscape(name) + "/ips/" + url.PathEscape(ip)
+
+	responseBody, statusCode, err := c.Get(ctx, "DELETE", endpoint)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, responseBody, ErrFailedRemovingIPFromPool),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
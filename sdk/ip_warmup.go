@@ -0,0 +1,138 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IPWarmup is an IP being warmed up: Sendgrid gradually increases the volume of emails sent from it.
+type IPWarmup struct {
+	IP        string `json:"ip"`
+	StartDate int64  `json:"start_date"` //nolint:tagliatelle
+}
+
+func parseIPWarmups(respBody string) ([]IPWarmup, RequestError) {
+	var body []IPWarmup
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP warmups: %w", err),
+		}
+	}
+
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// StartIPWarmup starts warming up an IP and returns its IPWarmup.
+func (c *Client) StartIPWarmup(ctx context.Context, ip string) (*IPWarmup, RequestError) {
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/ips/warmup", IPWarmup{IP: ip})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed starting IP warmup: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedStartingIPWarmup),
+		}
+	}
+
+	warmups, requestErr := parseIPWarmups(respBody)
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	for i := range warmups {
+		if warmups[i].IP == ip {
+			return &warmups[i], requestErr
+		}
+	}
+
+	return &IPWarmup{IP: ip}, requestErr
+}
+
+// ReadIPWarmups retrieves all the IPs being warmed up.
+func (c *Client) ReadIPWarmups(ctx context.Context) ([]IPWarmup, RequestError) {
+	respBody, statusCode, err := c.Get(ctx, "GET", "/ips/warmup")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseIPWarmups(respBody)
+}
+
+// ReadIPWarmup retrieves the IPWarmup of an IP, it isn't found once the warmup stopped.
+func (c *Client) ReadIPWarmup(ctx context.Context, ip string) (*IPWarmup, RequestError) {
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	warmups, err := c.ReadIPWarmups(ctx)
+	if err.Err != nil {
+		return nil, err
+	}
+
+	for i := range warmups {
+		if warmups[i].IP == ip {
+			return &warmups[i], RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+
+	return nil, RequestError{
+		StatusCode: http.StatusNotFound,
+		Err:        fmt.Errorf("%w: IP warmup %s", ErrNotFound, ip),
+	}
+}
+
+// StopIPWarmup stops warming up an IP.
+func (c *Client) StopIPWarmup(ctx context.Context, ip string) (bool, RequestError) {
+	if ip == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/ips/warmup/"+url.PathEscape(ip))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, responseBody, ErrFailedStoppingIPWarmup),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type LinkBrandingDNS struct {
@@ -32,6 +33,14 @@ type LinkBranding struct {
 	DNS       LinkBrandingDNS `json:"dns,omitempty"`
 }
 
+// LinkBrandingValidation is the outcome of the validation of a LinkBranding.
+// ValidationResults is keyed by the DNS record, i.e. domain_cname or owner_cname.
+type LinkBrandingValidation struct {
+	ID                int32                       `json:"id"`
+	Valid             bool                        `json:"valid"`
+	ValidationResults map[string]ValidationResult `json:"validation_results"` //nolint:tagliatelle
+}
+
 func parseLinkBranding(respBody string) (*LinkBranding, RequestError) {
 	var body LinkBranding
 	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
@@ -68,7 +77,7 @@ func (c *Client) CreateLinkBranding(ctx context.Context, domain string, subdomai
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingLinkBranding, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingLinkBranding),
 		}
 	}
 
@@ -84,7 +93,7 @@ func (c *Client) ReadLinkBranding(ctx context.Context, id string) (*LinkBranding
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/whitelabel/links/"+id)
+	respBody, statusCode, err := c.Get(ctx, "GET", "/whitelabel/links/"+id)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -92,6 +101,13 @@ func (c *Client) ReadLinkBranding(ctx context.Context, id string) (*LinkBranding
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseLinkBranding(respBody)
 }
 
@@ -107,7 +123,7 @@ func (c *Client) UpdateLinkBranding(ctx context.Context, id string, isDefault bo
 	t := LinkBranding{}
 	t.IsDefault = isDefault
 
-	respBody, _, err := c.Post(ctx, "PATCH", "/whitelabel/links/"+id, t)
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/whitelabel/links/"+id, t)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -115,26 +131,49 @@ func (c *Client) UpdateLinkBranding(ctx context.Context, id string, isDefault bo
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseLinkBranding(respBody)
 }
 
-func (c *Client) ValidateLinkBranding(ctx context.Context, id string) RequestError {
+// ValidateLinkBranding asks Sendgrid to check the DNS records of a LinkBranding and returns the outcome.
+func (c *Client) ValidateLinkBranding(ctx context.Context, id string) (*LinkBrandingValidation, RequestError) {
 	if id == "" {
-		return RequestError{
+		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        ErrLinkBrandingIDRequired,
 		}
 	}
 
-	_, statusCode, err := c.Post(ctx, "POST", "/whitelabel/links/"+id+"/validate", nil)
-	if err != nil || statusCode != 200 {
-		return RequestError{
+	respBody, statusCode, err := c.Post(ctx, "POST", "/whitelabel/links/"+id+"/validate", nil)
+	if err != nil {
+		return nil, RequestError{
 			StatusCode: statusCode,
 			Err:        err,
 		}
 	}
 
-	return RequestError{StatusCode: http.StatusOK, Err: nil}
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	var body LinkBrandingValidation
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing link branding validation: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
 }
 
 // DeleteLinkBranding deletes an LinkBranding.
@@ -157,7 +196,98 @@ func (c *Client) DeleteLinkBranding(ctx context.Context, id string) (bool, Reque
 	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
 		return false, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingLinkBranding, statusCode, responseBody),
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingLinkBranding),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AssociateLinkBrandingWithSubuser shares a LinkBranding of the parent account with a subuser.
+// A subuser can only be associated with one LinkBranding at a time.
+func (c *Client) AssociateLinkBrandingWithSubuser(ctx context.Context, id, username string) RequestError {
+	if id == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrLinkBrandingIDRequired,
+		}
+	}
+
+	if username == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/whitelabel/links/"+id+"/subuser", subuserAssociation{
+		Username: username,
+	})
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedAssociatingLinkBranding),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadSubuserLinkBranding retrieves the LinkBranding associated with a subuser and returns it.
+func (c *Client) ReadSubuserLinkBranding(ctx context.Context, username string) (*LinkBranding, RequestError) {
+	if username == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/whitelabel/links/subuser?username="+url.QueryEscape(username))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseLinkBranding(respBody)
+}
+
+// DisassociateSubuserLinkBranding stops sharing its LinkBranding with a subuser.
+func (c *Client) DisassociateSubuserLinkBranding(ctx context.Context, username string) (bool, RequestError) {
+	if username == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "DELETE", "/whitelabel/links/subuser?username="+url.QueryEscape(username))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedDisassociatingLinkBranding),
 		}
 	}
 
@@ -0,0 +1,136 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// MailSettingEnabled is a mail setting which can only be enabled or disabled,
+// like bypass_list_management or plain_content.
+type MailSettingEnabled struct {
+	Enabled bool `json:"enabled"`
+}
+
+// MailSettingFooter is the footer mail setting, appending content to every email.
+type MailSettingFooter struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`  //nolint:tagliatelle
+	PlainContent string `json:"plain_content"` //nolint:tagliatelle
+}
+
+// MailSettingForward is the forward_bounce or forward_spam mail setting, forwarding
+// bounces or spam reports to Email.
+type MailSettingForward struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// MailSettingBouncePurge is the bounce_purge mail setting, purging bounces after a number of days.
+// A nil number of days keeps the bounces.
+type MailSettingBouncePurge struct {
+	Enabled     bool `json:"enabled"`
+	SoftBounces *int `json:"soft_bounces"` //nolint:tagliatelle
+	HardBounces *int `json:"hard_bounces"` //nolint:tagliatelle
+}
+
+// MailSettingAddressWhitelist is the address_whitelist mail setting, never suppressing
+// the emails or domains of List.
+type MailSettingAddressWhitelist struct {
+	Enabled bool     `json:"enabled"`
+	List    []string `json:"list"`
+}
+
+// MailSettingSpamCheck is the spam_check mail setting, dropping emails scored above MaxScore
+// and posting them to URL.
+type MailSettingSpamCheck struct {
+	Enabled  bool   `json:"enabled"`
+	URL      string `json:"url"`
+	MaxScore int    `json:"max_score"` //nolint:tagliatelle
+}
+
+// ReadMailSetting retrieves the mail setting name, like footer, into setting,
+// a pointer to the MailSetting struct matching name.
+func (c *Client) ReadMailSetting(ctx context.Context, name string, setting interface{}) RequestError {
+	if name == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrMailSettingNameRequired,
+		}
+	}
+
+	return c.readSetting(ctx, "mail_settings", name, setting)
+}
+
+// UpdateMailSetting edits the mail setting name, like footer, and reads it back into setting,
+// a pointer to the MailSetting struct matching name.
+func (c *Client) UpdateMailSetting(ctx context.Context, name string, setting interface{}) RequestError {
+	if name == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrMailSettingNameRequired,
+		}
+	}
+
+	return c.updateSetting(ctx, "mail_settings", name, setting, ErrFailedUpdatingMailSetting)
+}
+
+// readSetting retrieves the setting name of the family of settings group, like mail_settings, into setting.
+func (c *Client) readSetting(ctx context.Context, group, name string, setting interface{}) RequestError {
+	respBody, statusCode, err := c.Get(ctx, "GET", "/"+group+"/"+url.PathEscape(name))
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseSetting(name, respBody, setting)
+}
+
+// updateSetting edits the setting name of the family of settings group, like mail_settings,
+// and reads it back into setting.
+func (c *Client) updateSetting(
+	ctx context.Context,
+	group string,
+	name string,
+	setting interface{},
+	failed error,
+) RequestError {
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/"+group+"/"+url.PathEscape(name), setting)
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating setting %s: %w", name, err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, failed),
+		}
+	}
+
+	return parseSetting(name, respBody, setting)
+}
+
+func parseSetting(name, respBody string, setting interface{}) RequestError {
+	if err := json.Unmarshal([]byte(respBody), setting); err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing setting %s: %w", name, err),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -65,7 +65,7 @@ func (c *Client) CreateParseWebhook(
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingParseWebhook, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingParseWebhook),
 		}
 	}
 
@@ -81,7 +81,7 @@ func (c *Client) ReadParseWebhook(ctx context.Context, hostname string) (*ParseW
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/parse/settings/"+hostname)
+	respBody, statusCode, err := c.Get(ctx, "GET", "/user/webhooks/parse/settings/"+hostname)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -89,9 +89,46 @@ func (c *Client) ReadParseWebhook(ctx context.Context, hostname string) (*ParseW
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseParseWebhook(respBody)
 }
 
+// ReadParseWebhooks retrieves every ParseWebhook of the account and returns them.
+func (c *Client) ReadParseWebhooks(ctx context.Context) ([]ParseWebhook, RequestError) {
+	respBody, statusCode, err := c.Get(ctx, "GET", "/user/webhooks/parse/settings")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	var body struct {
+		Result []ParseWebhook `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing inbound parses: %w", err),
+		}
+	}
+
+	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
 // UpdateParseWebhook edits an ParseWebhook and returns it.
 func (c *Client) UpdateParseWebhook(ctx context.Context, hostname string, spamCheck bool, sendRaw bool) RequestError {
 	if hostname == "" {
@@ -105,7 +142,7 @@ func (c *Client) UpdateParseWebhook(ctx context.Context, hostname string, spamCh
 	t.SpamCheck = spamCheck
 	t.SendRaw = sendRaw
 
-	_, _, err := c.Post(ctx, "PUT", "/user/webhooks/parse/settings/"+hostname, t)
+	respBody, statusCode, err := c.Post(ctx, "PUT", "/user/webhooks/parse/settings/"+hostname, t)
 	if err != nil {
 		return RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -113,6 +150,13 @@ func (c *Client) UpdateParseWebhook(ctx context.Context, hostname string, spamCh
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return RequestError{
 		StatusCode: http.StatusOK,
 	}
@@ -138,7 +182,7 @@ func (c *Client) DeleteParseWebhook(ctx context.Context, hostname string) (bool,
 	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
 		return false, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingParseWebhook, statusCode, responseBody),
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingParseWebhook),
 		}
 	}
 
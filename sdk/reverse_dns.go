@@ -0,0 +1,190 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ReverseDNSRecord is the A record pointing the domain of a ReverseDNS to its IP.
+type ReverseDNSRecord struct {
+	Valid bool   `json:"valid,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Host  string `json:"host,omitempty"`
+	Data  string `json:"data,omitempty"`
+}
+
+// ReverseDNSUser is a user allowed to send emails from the IP of a ReverseDNS.
+type ReverseDNSUser struct {
+	Username string `json:"username"`
+	UserID   int32  `json:"user_id"` //nolint:tagliatelle
+}
+
+// ReverseDNS is a Sendgrid reverse DNS, formerly known as IP whitelabel.
+type ReverseDNS struct {
+	ID                      int32            `json:"id,omitempty"`
+	IP                      string           `json:"ip"`
+	RDNS                    string           `json:"rdns,omitempty"`
+	Users                   []ReverseDNSUser `json:"users,omitempty"`
+	Domain                  string           `json:"domain"`
+	Subdomain               string           `json:"subdomain,omitempty"`
+	Valid                   bool             `json:"valid,omitempty"`
+	Legacy                  bool             `json:"legacy,omitempty"`
+	LastValidationAttemptAt int64            `json:"last_validation_attempt_at,omitempty"` //nolint:tagliatelle
+	ARecord                 ReverseDNSRecord `json:"a_record,omitempty"`                   //nolint:tagliatelle
+}
+
+// ValidationResult is the outcome of the validation of a DNS record, Reason explains why it isn't valid.
+type ValidationResult struct {
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason"`
+}
+
+// ReverseDNSValidation is the outcome of the validation of a ReverseDNS.
+type ReverseDNSValidation struct {
+	ID                int32 `json:"id"`
+	Valid             bool  `json:"valid"`
+	ValidationResults struct {
+		ARecord ValidationResult `json:"a_record"` //nolint:tagliatelle
+	} `json:"validation_results"` //nolint:tagliatelle
+}
+
+func parseReverseDNS(respBody string) (*ReverseDNS, RequestError) {
+	var body ReverseDNS
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing reverse DNS: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateReverseDNS creates a ReverseDNS and returns it.
+func (c *Client) CreateReverseDNS(ctx context.Context, ip, domain, subdomain string) (*ReverseDNS, RequestError) {
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	if domain == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrNameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/whitelabel/reverse_dns", ReverseDNS{
+		IP:        ip,
+		Domain:    domain,
+		Subdomain: subdomain,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating reverse DNS: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingReverseDNS),
+		}
+	}
+
+	return parseReverseDNS(respBody)
+}
+
+// ReadReverseDNS retrieves a ReverseDNS and returns it.
+func (c *Client) ReadReverseDNS(ctx context.Context, id string) (*ReverseDNS, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrReverseDNSIDRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/whitelabel/reverse_dns/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseReverseDNS(respBody)
+}
+
+// ValidateReverseDNS asks Sendgrid to check the A record of a ReverseDNS and returns the outcome.
+func (c *Client) ValidateReverseDNS(ctx context.Context, id string) (*ReverseDNSValidation, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrReverseDNSIDRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/whitelabel/reverse_dns/"+id+"/validate", nil)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	var body ReverseDNSValidation
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing reverse DNS validation: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// DeleteReverseDNS deletes a ReverseDNS.
+func (c *Client) DeleteReverseDNS(ctx context.Context, id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrReverseDNSIDRequired,
+		}
+	}
+
+	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/whitelabel/reverse_dns/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingReverseDNS),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
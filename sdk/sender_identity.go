@@ -0,0 +1,190 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// SenderIdentity is a Sendgrid verified sender.
+type SenderIdentity struct {
+	ID          int32  `json:"id,omitempty"`
+	Nickname    string `json:"nickname"`
+	FromEmail   string `json:"from_email"`              //nolint:tagliatelle
+	FromName    string `json:"from_name,omitempty"`     //nolint:tagliatelle
+	ReplyTo     string `json:"reply_to"`                //nolint:tagliatelle
+	ReplyToName string `json:"reply_to_name,omitempty"` //nolint:tagliatelle
+	Address     string `json:"address"`
+	Address2    string `json:"address2,omitempty"`
+	State       string `json:"state,omitempty"`
+	City        string `json:"city"`
+	Zip         string `json:"zip,omitempty"`
+	Country     string `json:"country"`
+	Verified    bool   `json:"verified,omitempty"`
+	Locked      bool   `json:"locked,omitempty"`
+}
+
+type senderIdentities struct {
+	Results []SenderIdentity `json:"results"`
+}
+
+func parseSenderIdentity(respBody string) (*SenderIdentity, RequestError) {
+	var body SenderIdentity
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing sender identity: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateSenderIdentity creates a SenderIdentity and returns it.
+// Sendgrid sends a verification email to its from_email.
+func (c *Client) CreateSenderIdentity(ctx context.Context, sender *SenderIdentity) (*SenderIdentity, RequestError) {
+	respBody, statusCode, err := c.Post(ctx, "POST", "/verified_senders", sender)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating sender identity: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingSenderIdentity),
+		}
+	}
+
+	return parseSenderIdentity(respBody)
+}
+
+// ReadSenderIdentity retrieves a SenderIdentity and returns it.
+func (c *Client) ReadSenderIdentity(ctx context.Context, id string) (*SenderIdentity, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrSenderIdentityIDRequired,
+		}
+	}
+
+	// There is no endpoint to retrieve a single verified sender, they can only be listed.
+	respBody, statusCode, err := c.Get(ctx, "GET", "/verified_senders?id="+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	var body senderIdentities
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing sender identities: %w", err),
+		}
+	}
+
+	for i := range body.Results {
+		if strconv.Itoa(int(body.Results[i].ID)) == id {
+			return &body.Results[i], RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+
+	return nil, RequestError{
+		StatusCode: http.StatusNotFound,
+		Err:        fmt.Errorf("%w: sender identity %s", ErrNotFound, id),
+	}
+}
+
+// UpdateSenderIdentity edits a SenderIdentity and returns it.
+func (c *Client) UpdateSenderIdentity(ctx context.Context, id string, sender *SenderIdentity) (*SenderIdentity, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrSenderIdentityIDRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/verified_senders/"+id, sender)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedUpdatingSenderIdentity),
+		}
+	}
+
+	return parseSenderIdentity(respBody)
+}
+
+// ResendSenderIdentityVerification sends the verification email of a SenderIdentity again.
+func (c *Client) ResendSenderIdentityVerification(ctx context.Context, id string) RequestError {
+	if id == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrSenderIdentityIDRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/verified_senders/resend/"+id, nil)
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// DeleteSenderIdentity deletes a SenderIdentity.
+func (c *Client) DeleteSenderIdentity(ctx context.Context, id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrSenderIdentityIDRequired,
+		}
+	}
+
+	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/verified_senders/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingSenderIdentity),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
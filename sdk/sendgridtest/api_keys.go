@@ -0,0 +1,107 @@
+package sendgridtest
+
+import (
+	"net/http"
+)
+
+func (s *Server) registerAPIKeys() {
+	s.handle(http.MethodPost, "/api_keys", s.createAPIKey)
+	s.handle(http.MethodGet, "/api_keys", s.listAPIKeys)
+	s.handle(http.MethodGet, "/api_keys/{id}", s.readAPIKey)
+	s.handle(http.MethodPut, "/api_keys/{id}", s.updateAPIKey)
+	s.handle(http.MethodPatch, "/api_keys/{id}", s.updateAPIKey)
+	s.handle(http.MethodDelete, "/api_keys/{id}", s.deleteAPIKey)
+}
+
+func (s *Server) createAPIKey(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "name") {
+		return
+	}
+
+	id := s.newStringID("key")
+	key := object{
+		"api_key_id": id,
+		"name":       body["name"],
+		"scopes":     withImplicitScopes(body["scopes"]),
+	}
+
+	a.collection("api_keys")[id] = key
+
+	created := object{"api_key": "SG." + id}
+	merge(created, key)
+
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// withImplicitScopes adds the scopes Sendgrid grants to every API key to the requested ones,
+// which default to a few common scopes when none are requested.
+func withImplicitScopes(requested interface{}) []string {
+	scopes := []string{}
+
+	list, _ := requested.([]interface{})
+	for _, scope := range list {
+		if s, ok := scope.(string); ok {
+			scopes = append(scopes, s)
+		}
+	}
+
+	if len(scopes) == 0 {
+		scopes = append(scopes, "mail.send", "alerts.create", "alerts.read")
+	}
+
+	for _, implicit := range []string{"2fa_required", "sender_verification_eligible"} {
+		found := false
+
+		for _, scope := range scopes {
+			found = found || scope == implicit
+		}
+
+		if !found {
+			scopes = append(scopes, implicit)
+		}
+	}
+
+	return scopes
+}
+
+func (s *Server) listAPIKeys(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+	writeJSON(w, http.StatusOK, object{"result": a.list("api_keys")})
+}
+
+func (s *Server) readAPIKey(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	key, ok := a.collection("api_keys")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, key)
+}
+
+func (s *Server) updateAPIKey(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	key, ok := a.collection("api_keys")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "name") {
+		return
+	}
+
+	merge(key, body, "name")
+
+	if scopes, ok := body["scopes"]; ok {
+		key["scopes"] = withImplicitScopes(scopes)
+	}
+
+	writeJSON(w, http.StatusOK, key)
+}
+
+func (s *Server) deleteAPIKey(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "api_keys", p["id"])
+}
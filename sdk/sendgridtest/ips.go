@@ -0,0 +1,331 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"sort"
+	"time"
+)
+
+// ips are the IP addresses of the parent account of a new Server.
+var ips = []string{"127.0.0.1", "255.255.255.255"}
+
+// ipsAssignedAt is the date the IPs of a new Server were assigned to it.
+const ipsAssignedAt = 1577836800
+
+func (s *Server) registerIPs() {
+	for _, ip := range ips {
+		s.account("").collection("ips")[ip] = object{
+			"ip":           ip,
+			"pools":        []string{},
+			"warmup":       false,
+			"start_date":   nil,
+			"whitelabeled": false,
+			"rdns":         "",
+			"assigned_at":  ipsAssignedAt,
+		}
+	}
+
+	s.handle(http.MethodPost, "/ips/pools", s.createIPPool)
+	s.handle(http.MethodGet, "/ips/pools", s.listIPPools)
+	s.handle(http.MethodGet, "/ips/pools/{name}", s.getIPPool)
+	s.handle(http.MethodPut, "/ips/pools/{name}", s.renameIPPool)
+	s.handle(http.MethodDelete, "/ips/pools/{name}", s.deleteIPPool)
+	s.handle(http.MethodPost, "/ips/pools/{name}/ips", s.addIPToPool)
+	s.handle(http.MethodDelete, "/ips/pools/{name}/ips/{ip}", s.removeIPFromPool)
+	s.handle(http.MethodPost, "/ips/warmup", s.startIPWarmup)
+	s.handle(http.MethodGet, "/ips/warmup", s.listIPWarmups)
+	s.handle(http.MethodGet, "/ips/warmup/{ip}", s.getIPWarmup)
+	s.handle(http.MethodDelete, "/ips/warmup/{ip}", s.stopIPWarmup)
+	s.handle(http.MethodGet, "/ips", s.listIPs)
+	s.handle(http.MethodGet, "/ips/{ip}", s.getIP)
+}
+
+func stringSlice(v interface{}) []string {
+	switch values := v.(type) {
+	case []string:
+		return values
+	case []interface{}:
+		s := make([]string, 0, len(values))
+		for _, value := range values {
+			if str, ok := value.(string); ok {
+				s = append(s, str)
+			}
+		}
+
+		return s
+	default:
+		return []string{}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func remove(values []string, value string) []string {
+	result := []string{}
+
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// ipWithSubusers returns ip along with the subusers it is assigned to.
+func ipWithSubusers(a *account, ip object) object {
+	subusers := []string{}
+
+	for _, subuser := range a.list("subusers") {
+		if contains(stringSlice(subuser["ips"]), ip["ip"].(string)) {
+			subusers = append(subusers, subuser["username"].(string))
+		}
+	}
+
+	result := object{"subusers": subusers}
+	merge(result, ip)
+
+	return result
+}
+
+func (s *Server) listIPs(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	subuser := r.URL.Query().Get("subuser")
+	result := []object{}
+
+	for _, ip := range a.list("ips") {
+		ip = ipWithSubusers(a, ip)
+		if subuser != "" && !contains(stringSlice(ip["subusers"]), subuser) {
+			continue
+		}
+
+		result = append(result, ip)
+	}
+
+	writeJSON(w, http.StatusOK, paginate(r, result))
+}
+
+func (s *Server) getIP(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	ip, ok := a.collection("ips")[p["ip"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ipWithSubusers(a, ip))
+}
+
+func (s *Server) createIPPool(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "name") {
+		return
+	}
+
+	name, _ := body["name"].(string)
+
+	pools := a.collection("ip_pools")
+	if _, exists := pools[name]; exists {
+		writeError(w, http.StatusBadRequest, "name", "pool name already exists")
+
+		return
+	}
+
+	pools[name] = object{"name": name}
+
+	writeJSON(w, http.StatusOK, pools[name])
+}
+
+func (s *Server) listIPPools(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+	writeJSON(w, http.StatusOK, a.list("ip_pools"))
+}
+
+func (s *Server) getIPPool(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	if _, ok := a.collection("ip_pools")[p["name"]]; !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	members := []object{}
+
+	for _, ip := range a.list("ips") {
+		if contains(stringSlice(ip["pools"]), p["name"]) {
+			members = append(members, object{
+				"ip":         ip["ip"],
+				"start_date": ip["start_date"],
+				"warmup":     ip["warmup"],
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, object{"pool_name": p["name"], "ips": members})
+}
+
+func (s *Server) renameIPPool(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	pools := a.collection("ip_pools")
+	if _, ok := pools[p["name"]]; !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "name") {
+		return
+	}
+
+	name, _ := body["name"].(string)
+	if _, exists := pools[name]; exists && name != p["name"] {
+		writeError(w, http.StatusBadRequest, "name", "pool name already exists")
+
+		return
+	}
+
+	delete(pools, p["name"])
+	pools[name] = object{"name": name}
+
+	for _, ip := range a.collection("ips") {
+		if ipPools := stringSlice(ip["pools"]); contains(ipPools, p["name"]) {
+			ip["pools"] = append(remove(ipPools, p["name"]), name)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, pools[name])
+}
+
+func (s *Server) deleteIPPool(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	for _, ip := range a.collection("ips") {
+		ip["pools"] = remove(stringSlice(ip["pools"]), p["name"])
+	}
+
+	deleteObject(w, a, "ip_pools", p["name"])
+}
+
+func (s *Server) addIPToPool(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	if _, ok := a.collection("ip_pools")[p["name"]]; !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "ip") {
+		return
+	}
+
+	address, _ := body["ip"].(string)
+
+	ip, ok := a.collection("ips")[address]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ip", "ip not found")
+
+		return
+	}
+
+	pools := stringSlice(ip["pools"])
+	if contains(pools, p["name"]) {
+		writeError(w, http.StatusBadRequest, "ip", "ip already in pool")
+
+		return
+	}
+
+	pools = append(pools, p["name"])
+	sort.Strings(pools)
+	ip["pools"] = pools
+
+	writeJSON(w, http.StatusCreated, object{
+		"ip":         address,
+		"pools":      pools,
+		"start_date": ip["start_date"],
+		"warmup":     ip["warmup"],
+	})
+}
+
+func (s *Server) removeIPFromPool(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	ip, ok := a.collection("ips")[p["ip"]]
+	if _, exists := a.collection("ip_pools")[p["name"]]; !ok || !exists || !contains(stringSlice(ip["pools"]), p["name"]) {
+		writeNotFound(w)
+
+		return
+	}
+
+	ip["pools"] = remove(stringSlice(ip["pools"]), p["name"])
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func warmup(ip object) object {
+	return object{"ip": ip["ip"], "start_date": ip["start_date"]}
+}
+
+func (s *Server) startIPWarmup(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "ip") {
+		return
+	}
+
+	address, _ := body["ip"].(string)
+
+	ip, ok := a.collection("ips")[address]
+	if !ok {
+		writeError(w, http.StatusNotFound, "ip", "ip not found")
+
+		return
+	}
+
+	if ip["warmup"] == true {
+		writeError(w, http.StatusBadRequest, "ip", "ip is already in warmup")
+
+		return
+	}
+
+	ip["warmup"] = true
+	ip["start_date"] = time.Now().Unix()
+
+	writeJSON(w, http.StatusOK, []object{warmup(ip)})
+}
+
+func (s *Server) listIPWarmups(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+	result := []object{}
+
+	for _, ip := range a.list("ips") {
+		if ip["warmup"] == true {
+			result = append(result, warmup(ip))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) getIPWarmup(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	ip, ok := a.collection("ips")[p["ip"]]
+	if !ok || ip["warmup"] != true {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, []object{warmup(ip)})
+}
+
+func (s *Server) stopIPWarmup(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	ip, ok := a.collection("ips")[p["ip"]]
+	if !ok || ip["warmup"] != true {
+		writeNotFound(w)
+
+		return
+	}
+
+	ip["warmup"] = false
+	ip["start_date"] = nil
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,40 @@
+package sendgridtest
+
+import (
+	"net/http"
+)
+
+// mailSettingDefaults are the mail settings of a new account, by name.
+var mailSettingDefaults = map[string]object{
+	"footer":                        {"enabled": false, "html_content": "", "plain_content": ""},
+	"bypass_list_management":        {"enabled": false},
+	"bypass_spam_management":        {"enabled": false},
+	"bypass_bounce_management":      {"enabled": false},
+	"bypass_unsubscribe_management": {"enabled": false},
+	"forward_bounce":                {"enabled": false, "email": ""},
+	"forward_spam":                  {"enabled": false, "email": ""},
+	"bounce_purge":                  {"enabled": false, "soft_bounces": nil, "hard_bounces": nil},
+	"address_whitelist":             {"enabled": false, "list": []string{}},
+	"spam_check":                    {"enabled": false, "url": "", "max_score": 5},
+	"plain_content":                 {"enabled": false},
+}
+
+// mailSettingValidators check the updates of the mail settings, by name.
+var mailSettingValidators = map[string]settingValidator{
+	"spam_check": validateSpamCheck,
+}
+
+func (s *Server) registerMailSettings() {
+	s.registerSettings("mail_settings", mailSettingDefaults, mailSettingValidators)
+}
+
+// validateSpamCheck checks that the maximum score of the spam_check mail setting is within its bounds.
+func validateSpamCheck(w http.ResponseWriter, body object) bool {
+	if score, ok := body["max_score"].(float64); ok && (score < 1 || score > 10) {
+		writeError(w, http.StatusBadRequest, "max_score", "max_score must be between 1 and 10")
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,107 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var senderIdentityFields = []string{
+	"nickname", "from_email", "from_name", "reply_to", "reply_to_name",
+	"address", "address2", "state", "city", "zip", "country",
+}
+
+func (s *Server) registerSenderIdentities() {
+	s.handle(http.MethodPost, "/verified_senders", s.createSenderIdentity)
+	s.handle(http.MethodGet, "/verified_senders", s.listSenderIdentities)
+	s.handle(http.MethodPatch, "/verified_senders/{id}", s.updateSenderIdentity)
+	s.handle(http.MethodDelete, "/verified_senders/{id}", s.deleteSenderIdentity)
+	s.handle(http.MethodPost, "/verified_senders/resend/{id}", s.resendSenderIdentityVerification)
+}
+
+func (s *Server) createSenderIdentity(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "nickname", "from_email", "reply_to", "address", "city", "country") {
+		return
+	}
+
+	for _, sender := range a.list("sender_identities") {
+		if sender["from_email"] == body["from_email"] {
+			writeError(w, http.StatusBadRequest, "from_email", "already exists")
+
+			return
+		}
+	}
+
+	id := s.newID()
+	sender := object{
+		"id":       id,
+		"verified": false,
+		"locked":   false,
+	}
+
+	for _, f := range senderIdentityFields {
+		sender[f] = ""
+	}
+
+	merge(sender, body, senderIdentityFields...)
+	a.collection("sender_identities")[strconv.Itoa(id)] = sender
+
+	writeJSON(w, http.StatusCreated, sender)
+}
+
+func (s *Server) listSenderIdentities(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	id := r.URL.Query().Get("id")
+	results := []object{}
+
+	for key, sender := range a.collection("sender_identities") {
+		if id == "" || key == id {
+			results = append(results, sender)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, object{"results": results})
+}
+
+func (s *Server) updateSenderIdentity(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	sender, ok := a.collection("sender_identities")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	// Changing the from email requires verifying it again.
+	if email, ok := body["from_email"]; ok && email != sender["from_email"] {
+		sender["verified"] = false
+	}
+
+	merge(sender, body, senderIdentityFields...)
+
+	writeJSON(w, http.StatusOK, sender)
+}
+
+func (s *Server) deleteSenderIdentity(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "sender_identities", p["id"])
+}
+
+func (s *Server) resendSenderIdentityVerification(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	sender, ok := a.collection("sender_identities")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	if sender["verified"] == true {
+		writeError(w, http.StatusBadRequest, "", "sender is already verified")
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,400 @@
+// Package sendgridtest provides an in-memory stand-in for the Sendgrid v3 API,
+// so that the provider can be exercised without a live Sendgrid account.
+package sendgridtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APIKey is the only API key accepted by a Server.
+const APIKey = "SG.sendgridtest"
+
+type object = map[string]interface{}
+
+type params map[string]string
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, a *account, p params)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// Server is a fake Sendgrid API listening on a local address.
+// Every account, the parent one or a subuser selected with the On-Behalf-Of header,
+// has its own isolated set of objects.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	accounts map[string]*account
+	routes   []route
+	faults   []*fault
+	nextID   int
+}
+
+// fault is a failure injected with FailRequest.
+type fault struct {
+	method   string
+	segments []string
+	// skip is the number of matching requests to serve before failing.
+	skip   int
+	status int
+}
+
+type account struct {
+	collections map[string]map[string]object
+	settings    map[string]object
+}
+
+// NewServer starts a Server. Callers should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		accounts: map[string]*account{},
+		nextID:   1,
+	}
+
+	s.registerRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Host returns the base URL of the fake API, to be used as the host of a sendgrid.Client.
+func (s *Server) Host() string {
+	return s.URL + "/v3"
+}
+
+// FailRequest makes the Server answer the nth next request matching method and pattern,
+// e.g. /user/webhooks/event/settings/signed/{id}, with status instead of serving it,
+// so that the handling of API failures can be tested. nth starts at 1.
+func (s *Server) FailRequest(method, pattern string, nth, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		skip:     nth - 1,
+		status:   status,
+	})
+}
+
+// injectFault answers the request with the status of a matching fault, reporting whether it did so.
+func (s *Server) injectFault(w http.ResponseWriter, method string, segments []string) bool {
+	for i, f := range s.faults {
+		if _, ok := match(f.segments, segments); !ok || f.method != method {
+			continue
+		}
+
+		if f.skip > 0 {
+			f.skip--
+
+			continue
+		}
+
+		s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		writeError(w, f.status, "", "injected failure")
+
+		return true
+	}
+
+	return false
+}
+
+func (s *Server) handle(method, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+APIKey {
+		writeError(w, http.StatusUnauthorized, "", "authorization required")
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	onBehalfOf := r.Header.Get("On-Behalf-Of")
+	if _, ok := s.account("").collection("subusers")[onBehalfOf]; onBehalfOf != "" && !ok {
+		writeError(w, http.StatusUnauthorized, "", "authorization required")
+
+		return
+	}
+
+	a := s.account(onBehalfOf)
+	path := strings.TrimPrefix(r.URL.Path, "/v3/")
+	if path == r.URL.Path {
+		writeNotFound(w)
+
+		return
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if s.injectFault(w, r.Method, segments) {
+		return
+	}
+
+	pathMatched := false
+
+	for _, rt := range s.routes {
+		p, ok := match(rt.segments, segments)
+		if !ok {
+			continue
+		}
+
+		pathMatched = true
+
+		if rt.method == r.Method {
+			rt.handler(w, r, a, p)
+
+			return
+		}
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+
+		return
+	}
+
+	writeNotFound(w)
+}
+
+func match(pattern, segments []string) (params, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	p := params{}
+
+	for i, seg := range pattern {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			p[strings.Trim(seg, "{}")] = segments[i]
+
+			continue
+		}
+
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+
+	return p, true
+}
+
+func (s *Server) account(name string) *account {
+	a, ok := s.accounts[name]
+	if !ok {
+		a = &account{
+			collections: map[string]map[string]object{},
+			settings:    map[string]object{},
+		}
+		s.accounts[name] = a
+	}
+
+	return a
+}
+
+func (s *Server) newID() int {
+	id := s.nextID
+	s.nextID++
+
+	return id
+}
+
+func (s *Server) newStringID(prefix string) string {
+	return fmt.Sprintf("%s%08x", prefix, s.newID())
+}
+
+// newUUID returns a new ID shaped like a UUID, like the IDs of the newer Sendgrid objects.
+func (s *Server) newUUID() string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", s.newID())
+}
+
+func (a *account) collection(name string) map[string]object {
+	c, ok := a.collections[name]
+	if !ok {
+		c = map[string]object{}
+		a.collections[name] = c
+	}
+
+	return c
+}
+
+// list returns the objects of a collection, ordered by ID.
+func (a *account) list(name string) []object {
+	c := a.collection(name)
+
+	ids := make([]string, 0, len(c))
+	for id := range c {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	objects := make([]object, 0, len(ids))
+	for _, id := range ids {
+		objects = append(objects, c[id])
+	}
+
+	return objects
+}
+
+func readBody(r *http.Request, v interface{}) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("failed reading request body: %w", err)
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed parsing request body: %w", err)
+	}
+
+	return nil
+}
+
+// decodeInto reads the JSON body of r into v, answering with a 400 when it isn't valid.
+func decodeInto(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := readBody(r, v); err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return false
+	}
+
+	return true
+}
+
+// decode reads the JSON object body of r, answering with a 400 when it isn't valid.
+func decode(w http.ResponseWriter, r *http.Request) (object, bool) {
+	o := object{}
+
+	return o, decodeInto(w, r, &o)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, field, message string) {
+	e := object{"message": message}
+	if field != "" {
+		e["field"] = field
+	} else {
+		e["field"] = nil
+	}
+
+	writeJSON(w, status, object{"errors": []object{e}})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "", "resource not found")
+}
+
+// merge copies every key of src into dst, restricted to keys when any are given.
+func merge(dst, src object, keys ...string) {
+	if len(keys) == 0 {
+		for k, v := range src {
+			dst[k] = v
+		}
+
+		return
+	}
+
+	for _, k := range keys {
+		if v, ok := src[k]; ok {
+			dst[k] = v
+		}
+	}
+}
+
+func require(w http.ResponseWriter, o object, fields ...string) bool {
+	for _, f := range fields {
+		if v, ok := o[f]; !ok || v == nil || v == "" {
+			writeError(w, http.StatusBadRequest, f, "the "+f+" field is required")
+
+			return false
+		}
+	}
+
+	return true
+}
+
+func now() string {
+	return time.Now().UTC().Format("2006-01-02 15:04:05")
+}
+
+func (s *Server) registerRoutes() {
+	s.registerAPIKeys()
+	s.registerSubusers()
+	s.registerTemplates()
+	s.registerWhitelabel()
+	s.registerWebhooks()
+	s.registerUnsubscribeGroups()
+	s.registerSSO()
+	s.registerSenderIdentities()
+	s.registerTeammates()
+	s.registerIPs()
+	s.registerSuppressions()
+	s.registerMailSettings()
+	s.registerTrackingSettings()
+}
+
+// paginate returns the page of result selected by the limit and offset query parameters of r.
+// Negative parameters are clamped to 0.
+func paginate(r *http.Request, result []object) []object {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if offset < 0 {
+		offset = 0
+	} else if offset > len(result) {
+		offset = len(result)
+	}
+
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit < 0 {
+		limit = 0
+	} else if err != nil || offset+limit > len(result) {
+		limit = len(result) - offset
+	}
+
+	return result[offset : offset+limit]
+}
+
+// deleteObject removes an object from a collection, answering like Sendgrid does.
+func deleteObject(w http.ResponseWriter, a *account, collection, id string) {
+	c := a.collection(collection)
+	if _, ok := c[id]; !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	delete(c, id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,177 @@
+package sendgridtest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/sendgrid/rest"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+	"github.com/taharah/terraform-provider-sendgrid/sdk/sendgridtest"
+)
+
+func TestServer_Status(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		apiKey   string
+		method   rest.Method
+		endpoint string
+		body     interface{}
+		want     int
+	}{
+		{name: "unauthorized", apiKey: "SG.invalid", method: http.MethodGet, endpoint: "/api_keys", want: http.StatusUnauthorized},
+		{name: "unknown endpoint", method: http.MethodGet, endpoint: "/unknown", want: http.StatusNotFound},
+		{name: "method not allowed", method: http.MethodPut, endpoint: "/api_keys", body: struct{}{}, want: http.StatusMethodNotAllowed},
+		{name: "missing field", method: http.MethodPost, endpoint: "/asm/groups", body: struct{}{}, want: http.StatusBadRequest},
+		{name: "created", method: http.MethodPost, endpoint: "/asm/groups", body: sendgrid.UnsubscribeGroup{Name: "group"}, want: http.StatusCreated},
+		{name: "not found", method: http.MethodGet, endpoint: "/asm/groups/0", want: http.StatusNotFound},
+		{name: "deleted missing", method: http.MethodDelete, endpoint: "/templates/0", want: http.StatusNotFound},
+		{name: "negative offset", method: http.MethodGet, endpoint: "/suppression/unsubscribes?offset=-1", want: http.StatusOK},
+		{name: "negative limit", method: http.MethodGet, endpoint: "/ips?limit=-1", want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			apiKey := tt.apiKey
+			if apiKey == "" {
+				apiKey = sendgridtest.APIKey
+			}
+
+			c := sendgrid.NewClient(apiKey, server.Host(), "")
+
+			var (
+				status int
+				err    error
+			)
+
+			if tt.body == nil {
+				_, status, err = c.Get(context.Background(), tt.method, tt.endpoint)
+			} else {
+				_, status, err = c.Post(context.Background(), tt.method, tt.endpoint, tt.body)
+			}
+
+			if err != nil {
+				t.Fatalf("request error = %v", err)
+			}
+
+			if status != tt.want {
+				t.Errorf("status = %d, want %d", status, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_FailRequest(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.FailRequest(http.MethodGet, "/asm/groups/{id}", 2, http.StatusBadGateway)
+
+	c := sendgrid.NewClient(sendgridtest.APIKey, server.Host(), "")
+	c.MaxRetries = 0
+
+	for i, want := range []int{http.StatusNotFound, http.StatusBadGateway, http.StatusNotFound} {
+		_, status, err := c.Get(context.Background(), http.MethodGet, "/asm/groups/0")
+		if err != nil {
+			t.Fatalf("request %d error = %v", i+1, err)
+		}
+
+		if status != want {
+			t.Errorf("request %d status = %d, want %d", i+1, status, want)
+		}
+	}
+}
+
+func TestServer_Template(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	c := sendgrid.NewClient(sendgridtest.APIKey, server.Host(), "")
+
+	template, err := c.CreateTemplate(ctx, "template", "dynamic")
+	if err != nil {
+		t.Fatalf("CreateTemplate() error = %v", err)
+	}
+
+	version, err := c.CreateTemplateVersion(ctx, sendgrid.TemplateVersion{
+		TemplateID:           template.ID,
+		Name:                 "version",
+		Subject:              "subject",
+		HTMLContent:          "<p>Hello</p>",
+		GeneratePlainContent: true,
+		Active:               1,
+	})
+	if err != nil {
+		t.Fatalf("CreateTemplateVersion() error = %v", err)
+	}
+
+	if version.PlainContent != "Hello" {
+		t.Errorf("CreateTemplateVersion() plain_content = %q, want %q", version.PlainContent, "Hello")
+	}
+
+	got, err := c.ReadTemplate(ctx, template.ID)
+	if err != nil {
+		t.Fatalf("ReadTemplate() error = %v", err)
+	}
+
+	if len(got.Versions) != 1 || got.Versions[0].ID != version.ID {
+		t.Errorf("ReadTemplate() versions = %v, want [%s]", got.Versions, version.ID)
+	}
+
+	templates, err := c.ReadTemplates(ctx, "dynamic")
+	if err != nil {
+		t.Fatalf("ReadTemplates() error = %v", err)
+	}
+
+	if len(templates) != 1 || templates[0].ID != template.ID {
+		t.Errorf("ReadTemplates() = %v, want [%s]", templates, template.ID)
+	}
+
+	if _, err := c.DeleteTemplate(ctx, template.ID); err != nil {
+		t.Fatalf("DeleteTemplate() error = %v", err)
+	}
+
+	endpoint := "/templates/" + template.ID + "/versions/" + version.ID
+	if _, status, _ := c.Get(ctx, http.MethodGet, endpoint); status != http.StatusNotFound {
+		t.Errorf("version of a deleted template status = %d, want %d", status, http.StatusNotFound)
+	}
+}
+
+func TestServer_OnBehalfOf(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	c := sendgrid.NewClient(sendgridtest.APIKey, server.Host(), "")
+
+	if _, err := c.CreateSubuser(ctx, "subuser", "subuser@example.org", "password", []string{"127.0.0.1"}); err.Err != nil {
+		t.Fatalf("CreateSubuser() error = %v", err.Err)
+	}
+
+	if _, err := c.CreateUnsubscribeGroup(sendgrid.WithOnBehalfOf(ctx, "subuser"), "group", "", false); err.Err != nil {
+		t.Fatalf("CreateUnsubscribeGroup() error = %v", err.Err)
+	}
+
+	parent, err := c.ReadUnsubscribeGroups(ctx)
+	if err.Err != nil {
+		t.Fatalf("ReadUnsubscribeGroups() error = %v", err.Err)
+	}
+
+	subuser, err := c.ReadUnsubscribeGroups(sendgrid.WithOnBehalfOf(ctx, "subuser"))
+	if err.Err != nil {
+		t.Fatalf("ReadUnsubscribeGroups() on behalf of subuser error = %v", err.Err)
+	}
+
+	if len(parent) != 0 || len(subuser) != 1 {
+		t.Errorf("ReadUnsubscribeGroups() = %d parent and %d subuser groups, want 0 and 1", len(parent), len(subuser))
+	}
+
+	if _, status, _ := c.Get(sendgrid.WithOnBehalfOf(ctx, "unknown"), http.MethodGet, "/asm/groups"); status != http.StatusUnauthorized {
+		t.Errorf("on behalf of an unknown subuser status = %d, want %d", status, http.StatusUnauthorized)
+	}
+}
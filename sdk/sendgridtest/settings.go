@@ -0,0 +1,80 @@
+package sendgridtest
+
+import (
+	"net/http"
+)
+
+// settingValidator checks the body of an update of a setting, answering with a 400 when it isn't valid.
+type settingValidator func(w http.ResponseWriter, body object) bool
+
+// registerSettings serves the family of settings group, like mail_settings, starting from defaults.
+// The updates of the settings having a validator, by name, are checked by it.
+func (s *Server) registerSettings(group string, defaults map[string]object, validators map[string]settingValidator) {
+	s.handle(http.MethodGet, "/"+group+"/{name}", func(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+		readSetting(w, a, group, defaults, p["name"])
+	})
+	s.handle(http.MethodPatch, "/"+group+"/{name}", func(w http.ResponseWriter, r *http.Request, a *account, p params) {
+		updateSetting(w, r, a, group, defaults, validators[p["name"]], p["name"])
+	})
+}
+
+// setting returns the setting name of group, answering with a 404 when there is no such setting.
+func setting(w http.ResponseWriter, a *account, group string, defaults map[string]object, name string) (object, bool) {
+	d, ok := defaults[name]
+	if !ok {
+		writeNotFound(w)
+
+		return nil, false
+	}
+
+	st, ok := a.settings[group+"/"+name]
+	if !ok {
+		st = object{}
+		merge(st, d)
+		a.settings[group+"/"+name] = st
+	}
+
+	return st, true
+}
+
+func readSetting(w http.ResponseWriter, a *account, group string, defaults map[string]object, name string) {
+	st, ok := setting(w, a, group, defaults, name)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, st)
+}
+
+func updateSetting(
+	w http.ResponseWriter,
+	r *http.Request,
+	a *account,
+	group string,
+	defaults map[string]object,
+	validate settingValidator,
+	name string,
+) {
+	st, ok := setting(w, a, group, defaults, name)
+	if !ok {
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	if validate != nil && !validate(w, body) {
+		return
+	}
+
+	fields := make([]string, 0, len(st))
+	for field := range st {
+		fields = append(fields, field)
+	}
+
+	merge(st, body, fields...)
+
+	writeJSON(w, http.StatusOK, st)
+}
@@ -0,0 +1,162 @@
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var ssoIntegrationFields = []string{"name", "enabled", "signin_url", "signout_url", "entity_id", "completed_integration"}
+
+func (s *Server) registerSSO() {
+	s.handle(http.MethodPost, "/sso/integrations", s.createSSOIntegration)
+	s.handle(http.MethodGet, "/sso/integrations", s.listSSOIntegrations)
+	s.handle(http.MethodGet, "/sso/integrations/{id}", s.readSSOIntegration)
+	s.handle(http.MethodPatch, "/sso/integrations/{id}", s.updateSSOIntegration)
+	s.handle(http.MethodDelete, "/sso/integrations/{id}", s.deleteSSOIntegration)
+	s.handle(http.MethodGet, "/sso/integrations/{id}/certificates", s.listSSOCertificates)
+
+	s.handle(http.MethodPost, "/sso/certificates", s.createSSOCertificate)
+	s.handle(http.MethodGet, "/sso/certificates", s.listSSOCertificates)
+	s.handle(http.MethodGet, "/sso/certificates/{id}", s.readSSOCertificate)
+	s.handle(http.MethodPatch, "/sso/certificates/{id}", s.updateSSOCertificate)
+	s.handle(http.MethodDelete, "/sso/certificates/{id}", s.deleteSSOCertificate)
+}
+
+func (s *Server) createSSOIntegration(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "name", "signin_url", "signout_url", "entity_id") {
+		return
+	}
+
+	id := fmt.Sprintf("%08x-0000-4000-8000-000000000000", s.newID())
+	integration := object{
+		"id":                    id,
+		"enabled":               false,
+		"completed_integration": false,
+		"single_signon_url":     s.URL + "/sso/saml/" + id,
+		"audience_url":          s.URL + "/sso/saml/" + id + "/metadata",
+	}
+
+	merge(integration, body, ssoIntegrationFields...)
+	a.saveSSOIntegration(integration)
+
+	writeJSON(w, http.StatusCreated, integration)
+}
+
+func (a *account) saveSSOIntegration(integration object) {
+	integration["last_updated"] = time.Now().Unix()
+	a.collection("sso_integrations")[integration["id"].(string)] = integration
+}
+
+func (s *Server) listSSOIntegrations(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+	writeJSON(w, http.StatusOK, a.list("sso_integrations"))
+}
+
+func (s *Server) readSSOIntegration(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	integration, ok := a.collection("sso_integrations")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, integration)
+}
+
+func (s *Server) updateSSOIntegration(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	integration, ok := a.collection("sso_integrations")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	merge(integration, body, ssoIntegrationFields...)
+	a.saveSSOIntegration(integration)
+
+	writeJSON(w, http.StatusOK, integration)
+}
+
+func (s *Server) deleteSSOIntegration(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "sso_integrations", p["id"])
+}
+
+func (s *Server) createSSOCertificate(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "public_certificate", "integration_id") {
+		return
+	}
+
+	if _, ok := a.collection("sso_integrations")[body["integration_id"].(string)]; !ok {
+		writeError(w, http.StatusBadRequest, "integration_id", "integration not found")
+
+		return
+	}
+
+	id := s.newID()
+	certificate := object{
+		"id":         id,
+		"not_before": time.Now().Unix(),
+		"not_after":  time.Now().AddDate(1, 0, 0).Unix(),
+	}
+
+	merge(certificate, body, "public_certificate", "integration_id")
+	a.collection("sso_certificates")[strconv.Itoa(id)] = certificate
+
+	writeJSON(w, http.StatusCreated, certificate)
+}
+
+// listSSOCertificates lists certificates, restricted to a single integration when one is given in the path.
+func (s *Server) listSSOCertificates(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	result := []object{}
+
+	for _, certificate := range a.list("sso_certificates") {
+		if id, ok := p["id"]; ok && certificate["integration_id"] != id {
+			continue
+		}
+
+		result = append(result, certificate)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) readSSOCertificate(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	certificate, ok := a.collection("sso_certificates")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, certificate)
+}
+
+func (s *Server) updateSSOCertificate(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	certificate, ok := a.collection("sso_certificates")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	merge(certificate, body, "public_certificate", "integration_id")
+
+	writeJSON(w, http.StatusOK, certificate)
+}
+
+func (s *Server) deleteSSOCertificate(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "sso_certificates", p["id"])
+}
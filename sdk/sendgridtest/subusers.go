@@ -0,0 +1,263 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// creditResetFrequencies are the periods recurring credits can be reset every.
+var creditResetFrequencies = []string{"daily", "weekly", "monthly"}
+
+func (s *Server) registerSubusers() {
+	s.handle(http.MethodPost, "/subusers", s.createSubuser)
+	s.handle(http.MethodGet, "/subusers", s.listSubusers)
+	s.handle(http.MethodPatch, "/subusers/{username}", s.updateSubuser)
+	s.handle(http.MethodDelete, "/subusers/{username}", s.deleteSubuser)
+	s.handle(http.MethodPut, "/subusers/{username}/ips", s.updateSubuserIPs)
+	s.handle(http.MethodGet, "/subusers/{username}/credits", s.readSubuserCredits)
+	s.handle(http.MethodPut, "/subusers/{username}/credits", s.updateSubuserCredits)
+	s.handle(http.MethodPost, "/subusers/{username}/monitor", s.createSubuserMonitor)
+	s.handle(http.MethodGet, "/subusers/{username}/monitor", s.readSubuserMonitor)
+	s.handle(http.MethodPut, "/subusers/{username}/monitor", s.updateSubuserMonitor)
+	s.handle(http.MethodDelete, "/subusers/{username}/monitor", s.deleteSubuserMonitor)
+	s.handle(http.MethodPut, "/user/password", s.updatePassword)
+}
+
+func (s *Server) createSubuser(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "username", "email", "password", "ips") {
+		return
+	}
+
+	username, _ := body["username"].(string)
+
+	subusers := a.collection("subusers")
+	if _, exists := subusers[username]; exists {
+		writeError(w, http.StatusBadRequest, "username", "username exists")
+
+		return
+	}
+
+	subuser := object{
+		"id":       s.newID(),
+		"username": username,
+		"email":    body["email"],
+		"disabled": false,
+		"ips":      body["ips"],
+		"credits":  object{"type": "unlimited", "reset_frequency": nil, "total": nil, "remain": nil, "used": 0},
+	}
+	subusers[username] = subuser
+
+	writeJSON(w, http.StatusCreated, object{
+		"username":             username,
+		"user_id":              subuser["id"],
+		"email":                subuser["email"],
+		"signup_session_token": "",
+		"authorization_token":  "",
+		"credit_allocation":    object{"type": "unlimited"},
+	})
+}
+
+func (s *Server) listSubusers(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	username := r.URL.Query().Get("username")
+	result := []object{}
+
+	for _, subuser := range a.list("subusers") {
+		// Like Sendgrid, the username filter matches the usernames starting with it.
+		if !strings.HasPrefix(subuser["username"].(string), username) {
+			continue
+		}
+
+		result = append(result, object{
+			"id":       subuser["id"],
+			"username": subuser["username"],
+			"email":    subuser["email"],
+			"disabled": subuser["disabled"],
+		})
+	}
+
+	// Subusers are listed in the order they were created.
+	sort.Slice(result, func(i, j int) bool { return result[i]["id"].(int) < result[j]["id"].(int) })
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) updateSubuser(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	subuser, ok := a.collection("subusers")[p["username"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	merge(subuser, body, "disabled")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSubuser(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	if _, ok := a.collection("subusers")[p["username"]]; ok {
+		delete(s.accounts, p["username"])
+	}
+
+	deleteObject(w, a, "subusers", p["username"])
+}
+
+func (s *Server) updateSubuserIPs(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	subuser, ok := a.collection("subusers")[p["username"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	var ips []string
+	if !decodeInto(w, r, &ips) {
+		return
+	}
+
+	subuser["ips"] = ips
+
+	writeJSON(w, http.StatusOK, object{"ips": ips})
+}
+
+func (s *Server) updatePassword(w http.ResponseWriter, r *http.Request, _ *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "new_password") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{})
+}
+
+func (s *Server) readSubuserCredits(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	subuser, ok := a.collection("subusers")[p["username"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, subuser["credits"])
+}
+
+func (s *Server) updateSubuserCredits(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	subuser, ok := a.collection("subusers")[p["username"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "type") {
+		return
+	}
+
+	credits := object{"type": body["type"], "reset_frequency": nil, "total": nil, "remain": nil, "used": 0}
+
+	switch body["type"] {
+	case "unlimited":
+	case "recurring":
+		if frequency, _ := body["reset_frequency"].(string); !contains(creditResetFrequencies, frequency) {
+			writeError(w, http.StatusBadRequest, "reset_frequency", "reset_frequency must be daily, weekly or monthly")
+
+			return
+		}
+
+		credits["reset_frequency"] = body["reset_frequency"]
+
+		fallthrough
+	case "nonrecurring":
+		if !require(w, body, "total") {
+			return
+		}
+
+		credits["total"] = body["total"]
+		credits["remain"] = body["total"]
+	default:
+		writeError(w, http.StatusBadRequest, "type", "type must be unlimited, recurring or nonrecurring")
+
+		return
+	}
+
+	subuser["credits"] = credits
+
+	writeJSON(w, http.StatusOK, credits)
+}
+
+func (s *Server) createSubuserMonitor(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	subuser, ok := a.collection("subusers")[p["username"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	if subuser["monitor"] != nil {
+		writeError(w, http.StatusBadRequest, "", "User already has a monitor")
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "email", "frequency") {
+		return
+	}
+
+	monitor := object{}
+	merge(monitor, body, "email", "frequency")
+	subuser["monitor"] = monitor
+
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+func (s *Server) readSubuserMonitor(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	subuser, ok := a.collection("subusers")[p["username"]]
+	if !ok || subuser["monitor"] == nil {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, subuser["monitor"])
+}
+
+func (s *Server) updateSubuserMonitor(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	subuser, ok := a.collection("subusers")[p["username"]]
+	if !ok || subuser["monitor"] == nil {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "email", "frequency") {
+		return
+	}
+
+	monitor := object{}
+	merge(monitor, body, "email", "frequency")
+	subuser["monitor"] = monitor
+
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+func (s *Server) deleteSubuserMonitor(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	subuser, ok := a.collection("subusers")[p["username"]]
+	if !ok || subuser["monitor"] == nil {
+		writeNotFound(w)
+
+		return
+	}
+
+	delete(subuser, "monitor")
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,203 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// suppressions are the bounces, blocks, spam reports and invalid emails of the parent account of a new Server,
+// Sendgrid adding them as it delivers emails.
+var suppressions = map[string][]object{
+	"bounces": {
+		{
+			"email": "bounced@example.org", "created": 1577836800,
+			"reason": "550 5.1.1 The email account that you tried to reach does not exist", "status": "5.1.1",
+		},
+		{
+			"email": "full@example.org", "created": 1580515200,
+			"reason": "552 5.2.2 The email account that you tried to reach is over quota", "status": "5.2.2",
+		},
+	},
+	"blocks": {
+		{
+			"email": "blocked@example.org", "created": 1577836800,
+			"reason": "554 5.7.1 Message rejected due to local policy", "status": "5.7.1",
+		},
+	},
+	"spam_reports": {
+		{"email": "complained@example.org", "created": 1577836800, "ip": "127.0.0.1"},
+	},
+	"invalid_emails": {
+		{"email": "invalid@example", "created": 1577836800, "reason": "Mail domain mentioned in email address is unknown"},
+	},
+}
+
+func (s *Server) registerSuppressions() {
+	for list, listed := range suppressions {
+		for _, suppression := range listed {
+			seeded := object{}
+			merge(seeded, suppression)
+			s.account("").collection(list)[suppression["email"].(string)] = seeded
+		}
+	}
+
+	for list := range suppressions {
+		list := list
+		s.handle(http.MethodGet, "/suppression/"+list, func(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+			listSuppressions(w, r, a, list)
+		})
+	}
+
+	s.handle(http.MethodPost, "/asm/suppressions/global", s.addGlobalSuppressions)
+	s.handle(http.MethodGet, "/asm/suppressions/global/{email}", s.readGlobalSuppression)
+	s.handle(http.MethodDelete, "/asm/suppressions/global/{email}", s.deleteGlobalSuppression)
+	s.handle(http.MethodGet, "/suppression/unsubscribes", s.listGlobalSuppressions)
+
+	s.handle(http.MethodPost, "/asm/groups/{id}/suppressions", s.addGroupSuppressions)
+	s.handle(http.MethodGet, "/asm/groups/{id}/suppressions", s.listGroupSuppressions)
+	s.handle(http.MethodPost, "/asm/groups/{id}/suppressions/search", s.searchGroupSuppressions)
+	s.handle(http.MethodDelete, "/asm/groups/{id}/suppressions/{email}", s.deleteGroupSuppression)
+}
+
+// addSuppressions adds the recipient_emails of the request to a suppression collection.
+func addSuppressions(w http.ResponseWriter, r *http.Request, suppressions map[string]object) {
+	var body struct {
+		RecipientEmails []string `json:"recipient_emails"`
+	}
+	if !decodeInto(w, r, &body) {
+		return
+	}
+
+	if len(body.RecipientEmails) == 0 {
+		writeError(w, http.StatusBadRequest, "recipient_emails", "the recipient_emails field is required")
+
+		return
+	}
+
+	for _, email := range body.RecipientEmails {
+		if _, ok := suppressions[email]; !ok {
+			suppressions[email] = object{"email": email, "created": time.Now().Unix()}
+		}
+	}
+
+	writeJSON(w, http.StatusCreated, object{"recipient_emails": body.RecipientEmails})
+}
+
+// suppressedEmails returns the emails of a suppression collection, sorted.
+func suppressedEmails(a *account, collection string) []string {
+	emails := []string{}
+	for _, suppression := range a.list(collection) {
+		emails = append(emails, suppression["email"].(string))
+	}
+
+	return emails
+}
+
+func (s *Server) addGlobalSuppressions(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	addSuppressions(w, r, a.collection("global_suppressions"))
+}
+
+func (s *Server) readGlobalSuppression(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	if _, ok := a.collection("global_suppressions")[p["email"]]; !ok {
+		writeJSON(w, http.StatusOK, object{})
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"recipient_email": p["email"]})
+}
+
+func (s *Server) deleteGlobalSuppression(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "global_suppressions", p["email"])
+}
+
+func (s *Server) listGlobalSuppressions(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	writeJSON(w, http.StatusOK, paginate(r, a.list("global_suppressions")))
+}
+
+// listSuppressions answers with the suppressions of list matching the start_time, end_time and email
+// query parameters of r.
+func listSuppressions(w http.ResponseWriter, r *http.Request, a *account, list string) {
+	query := r.URL.Query()
+	startTime, _ := strconv.ParseInt(query.Get("start_time"), 10, 64)
+	endTime, _ := strconv.ParseInt(query.Get("end_time"), 10, 64)
+	email := query.Get("email")
+
+	result := []object{}
+
+	for _, suppression := range a.list(list) {
+		created := int64(suppression["created"].(int))
+
+		if (startTime != 0 && created < startTime) || (endTime != 0 && created > endTime) ||
+			(email != "" && suppression["email"] != email) {
+			continue
+		}
+
+		result = append(result, suppression)
+	}
+
+	writeJSON(w, http.StatusOK, paginate(r, result))
+}
+
+// groupSuppressions returns the collection of the emails unsubscribed from the group of p,
+// answering with a 404 when the group doesn't exist.
+func groupSuppressions(w http.ResponseWriter, a *account, p params) (string, bool) {
+	if _, ok := a.collection("groups")[p["id"]]; !ok {
+		writeNotFound(w)
+
+		return "", false
+	}
+
+	return "group_suppressions/" + p["id"], true
+}
+
+func (s *Server) addGroupSuppressions(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	collection, ok := groupSuppressions(w, a, p)
+	if !ok {
+		return
+	}
+
+	addSuppressions(w, r, a.collection(collection))
+}
+
+func (s *Server) listGroupSuppressions(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	collection, ok := groupSuppressions(w, a, p)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, suppressedEmails(a, collection))
+}
+
+func (s *Server) searchGroupSuppressions(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	collection, ok := groupSuppressions(w, a, p)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		RecipientEmails []string `json:"recipient_emails"`
+	}
+	if !decodeInto(w, r, &body) {
+		return
+	}
+
+	found := []string{}
+	for _, email := range body.RecipientEmails {
+		if _, ok := a.collection(collection)[email]; ok {
+			found = append(found, email)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, found)
+}
+
+func (s *Server) deleteGroupSuppression(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	collection, ok := groupSuppressions(w, a, p)
+	if !ok {
+		return
+	}
+
+	deleteObject(w, a, collection, p["email"])
+}
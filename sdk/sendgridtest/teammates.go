@@ -0,0 +1,221 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"strconv"
+)
+
+func (s *Server) registerTeammates() {
+	s.handle(http.MethodPost, "/teammates", s.inviteTeammate)
+	s.handle(http.MethodGet, "/teammates", s.listTeammates)
+	s.handle(http.MethodGet, "/teammates/pending", s.listPendingTeammates)
+	s.handle(http.MethodDelete, "/teammates/pending/{token}", s.deletePendingTeammate)
+	s.handle(http.MethodGet, "/teammates/{username}", s.getTeammate)
+	s.handle(http.MethodPatch, "/teammates/{username}", s.updateTeammate)
+	s.handle(http.MethodDelete, "/teammates/{username}", s.deleteTeammate)
+	s.handle(http.MethodGet, "/teammates/{username}/subuser_access", s.getTeammateSubuserAccess)
+	s.handle(http.MethodPost, "/sso/teammates", s.createSSOTeammate)
+	s.handle(http.MethodPatch, "/sso/teammates/{username}", s.updateSSOTeammate)
+}
+
+// emailInUse tells whether email belongs to a teammate, or to an invitation which wasn't accepted yet.
+func emailInUse(a *account, email interface{}) bool {
+	for _, collection := range []string{"teammates", "pending_teammates"} {
+		for _, teammate := range a.collection(collection) {
+			if teammate["email"] == email {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func userType(teammate object) string {
+	if teammate["is_admin"] == true {
+		return "admin"
+	}
+
+	return "teammate"
+}
+
+func (s *Server) inviteTeammate(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "email") {
+		return
+	}
+
+	if emailInUse(a, body["email"]) {
+		writeError(w, http.StatusBadRequest, "email", "email is already in use")
+
+		return
+	}
+
+	token := s.newStringID("tk")
+	invite := object{
+		"token":           token,
+		"email":           body["email"],
+		"is_admin":        body["is_admin"] == true,
+		"scopes":          body["scopes"],
+		"expiration_date": 1,
+	}
+	a.collection("pending_teammates")[token] = invite
+
+	writeJSON(w, http.StatusCreated, invite)
+}
+
+func (s *Server) listTeammates(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	teammates := a.list("teammates")
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if offset > len(teammates) {
+		offset = len(teammates)
+	}
+
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || offset+limit > len(teammates) {
+		limit = len(teammates) - offset
+	}
+
+	writeJSON(w, http.StatusOK, object{"result": teammates[offset : offset+limit]})
+}
+
+func (s *Server) listPendingTeammates(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+	writeJSON(w, http.StatusOK, object{"result": a.list("pending_teammates")})
+}
+
+func (s *Server) deletePendingTeammate(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "pending_teammates", p["token"])
+}
+
+func (s *Server) getTeammate(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	teammate, ok := a.collection("teammates")[p["username"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, teammate)
+}
+
+func (s *Server) updateTeammate(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	teammate, ok := a.collection("teammates")[p["username"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "scopes") {
+		return
+	}
+
+	merge(teammate, body, "is_admin", "scopes")
+	teammate["user_type"] = userType(teammate)
+
+	writeJSON(w, http.StatusOK, teammate)
+}
+
+func (s *Server) deleteTeammate(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "teammates", p["username"])
+}
+
+func (s *Server) getTeammateSubuserAccess(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	teammate, ok := a.collection("teammates")[p["username"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	access, _ := teammate["subuser_access"].([]interface{})
+	if access == nil {
+		access = []interface{}{}
+	}
+
+	writeJSON(w, http.StatusOK, object{
+		"has_restricted_subuser_access": teammate["has_restricted_subuser_access"] == true,
+		"subuser_access":                access,
+		"_metadata":                     object{},
+	})
+}
+
+// validSubuserAccess checks that every subuser an SSO teammate is given access to exists.
+func validSubuserAccess(w http.ResponseWriter, a *account, body object) bool {
+	access, _ := body["subuser_access"].([]interface{})
+
+	for _, entry := range access {
+		e, _ := entry.(object)
+
+		found := false
+
+		for _, subuser := range a.collection("subusers") {
+			if id, _ := subuser["id"].(int); float64(id) == e["id"] {
+				e["username"] = subuser["username"]
+				found = true
+			}
+		}
+
+		if !found {
+			writeError(w, http.StatusBadRequest, "subuser_access", "subuser not found")
+
+			return false
+		}
+
+		if e["permission_type"] != "admin" && e["permission_type"] != "restricted" {
+			writeError(w, http.StatusBadRequest, "permission_type", "must be admin or restricted")
+
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) createSSOTeammate(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "email", "first_name", "last_name") || !validSubuserAccess(w, a, body) {
+		return
+	}
+
+	if emailInUse(a, body["email"]) {
+		writeError(w, http.StatusBadRequest, "email", "email is already in use")
+
+		return
+	}
+
+	email, _ := body["email"].(string)
+	teammate := object{
+		"username": email,
+		"is_sso":   true,
+	}
+
+	merge(teammate, body, "email", "first_name", "last_name", "is_admin", "scopes",
+		"has_restricted_subuser_access", "subuser_access")
+	teammate["user_type"] = userType(teammate)
+	a.collection("teammates")[email] = teammate
+
+	writeJSON(w, http.StatusCreated, teammate)
+}
+
+func (s *Server) updateSSOTeammate(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	teammate, ok := a.collection("teammates")[p["username"]]
+	if !ok || teammate["is_sso"] != true {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !validSubuserAccess(w, a, body) {
+		return
+	}
+
+	merge(teammate, body, "first_name", "last_name", "is_admin", "scopes",
+		"has_restricted_subuser_access", "subuser_access")
+	teammate["user_type"] = userType(teammate)
+
+	writeJSON(w, http.StatusOK, teammate)
+}
@@ -0,0 +1,228 @@
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+func (s *Server) registerTemplates() {
+	s.handle(http.MethodPost, "/templates", s.createTemplate)
+	s.handle(http.MethodGet, "/templates", s.listTemplates)
+	s.handle(http.MethodGet, "/templates/{id}", s.readTemplate)
+	s.handle(http.MethodPatch, "/templates/{id}", s.updateTemplate)
+	s.handle(http.MethodDelete, "/templates/{id}", s.deleteTemplate)
+	s.handle(http.MethodPost, "/templates/{template_id}/versions", s.createTemplateVersion)
+	s.handle(http.MethodGet, "/templates/{template_id}/versions/{id}", s.readTemplateVersion)
+	s.handle(http.MethodPatch, "/templates/{template_id}/versions/{id}", s.updateTemplateVersion)
+	s.handle(http.MethodDelete, "/templates/{template_id}/versions/{id}", s.deleteTemplateVersion)
+}
+
+func (s *Server) createTemplate(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "name") {
+		return
+	}
+
+	generation, _ := body["generation"].(string)
+	if generation == "" {
+		generation = "legacy"
+	}
+
+	if generation != "legacy" && generation != "dynamic" {
+		writeError(w, http.StatusBadRequest, "generation", "generation must be one of [legacy, dynamic]")
+
+		return
+	}
+
+	id := fmt.Sprintf("%08x-0000-4000-8000-000000000000", s.newID())
+	if generation == "dynamic" {
+		id = "d-" + strings.ReplaceAll(id, "-", "")
+	}
+
+	template := object{
+		"id":         id,
+		"name":       body["name"],
+		"generation": generation,
+		"updated_at": now(),
+	}
+	a.collection("templates")[id] = template
+
+	writeJSON(w, http.StatusCreated, a.template(template))
+}
+
+// template returns t along with its versions, as Sendgrid renders it.
+func (a *account) template(t object) object {
+	versions := []object{}
+
+	for _, v := range a.list("template_versions") {
+		if v["template_id"] == t["id"] {
+			versions = append(versions, v)
+		}
+	}
+
+	rendered := object{"versions": versions}
+	merge(rendered, t)
+
+	return rendered
+}
+
+func (s *Server) listTemplates(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	generations := r.URL.Query().Get("generations")
+	if generations == "" {
+		generations = "legacy"
+	}
+
+	result := []object{}
+
+	for _, t := range a.list("templates") {
+		if !strings.Contains(generations, t["generation"].(string)) {
+			continue
+		}
+
+		result = append(result, a.template(t))
+	}
+
+	writeJSON(w, http.StatusOK, object{
+		"result":    result,
+		"_metadata": object{"count": len(result)},
+	})
+}
+
+func (s *Server) readTemplate(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	t, ok := a.collection("templates")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, a.template(t))
+}
+
+func (s *Server) updateTemplate(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	t, ok := a.collection("templates")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	merge(t, body, "name")
+	t["updated_at"] = now()
+
+	writeJSON(w, http.StatusOK, a.template(t))
+}
+
+func (s *Server) deleteTemplate(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	versions := a.collection("template_versions")
+	for id, v := range versions {
+		if v["template_id"] == p["id"] {
+			delete(versions, id)
+		}
+	}
+
+	deleteObject(w, a, "templates", p["id"])
+}
+
+func (s *Server) createTemplateVersion(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	if _, ok := a.collection("templates")[p["template_id"]]; !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "name", "subject") {
+		return
+	}
+
+	id := fmt.Sprintf("%08x-0000-4000-8000-000000000000", s.newID())
+	version := object{
+		"id":                     id,
+		"template_id":            p["template_id"],
+		"active":                 float64(0),
+		"editor":                 "code",
+		"generate_plain_content": true,
+		"thumbnail_url":          "",
+	}
+
+	merge(version, body, versionFields...)
+	a.saveTemplateVersion(version)
+
+	writeJSON(w, http.StatusCreated, version)
+}
+
+var versionFields = []string{
+	"active", "name", "html_content", "plain_content", "generate_plain_content", "subject", "editor", "test_data",
+}
+
+func (a *account) saveTemplateVersion(version object) {
+	version["updated_at"] = now()
+
+	if generate, _ := version["generate_plain_content"].(bool); generate {
+		html, _ := version["html_content"].(string)
+		version["plain_content"] = strings.TrimSpace(htmlTag.ReplaceAllString(html, ""))
+	}
+
+	versions := a.collection("template_versions")
+
+	// Only one version of a template can be active at a time.
+	if active, _ := version["active"].(float64); active == 1 {
+		for _, v := range versions {
+			if v["template_id"] == version["template_id"] {
+				v["active"] = float64(0)
+			}
+		}
+	}
+
+	versions[version["id"].(string)] = version
+}
+
+func (a *account) templateVersion(w http.ResponseWriter, p params) (object, bool) {
+	v, ok := a.collection("template_versions")[p["id"]]
+	if !ok || v["template_id"] != p["template_id"] {
+		writeNotFound(w)
+
+		return nil, false
+	}
+
+	return v, true
+}
+
+func (s *Server) readTemplateVersion(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	if v, ok := a.templateVersion(w, p); ok {
+		writeJSON(w, http.StatusOK, v)
+	}
+}
+
+func (s *Server) updateTemplateVersion(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	v, ok := a.templateVersion(w, p)
+	if !ok {
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	merge(v, body, versionFields...)
+	a.saveTemplateVersion(v)
+
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Server) deleteTemplateVersion(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	if _, ok := a.templateVersion(w, p); ok {
+		deleteObject(w, a, "template_versions", p["id"])
+	}
+}
@@ -0,0 +1,17 @@
+package sendgridtest
+
+// trackingSettingDefaults are the tracking settings of a new account, by name.
+var trackingSettingDefaults = map[string]object{
+	"click": {"enabled": true, "enable_text": false},
+	"open":  {"enabled": true},
+	"subscription": {
+		"enabled": false, "html_content": "", "plain_content": "", "landing": "", "replace": "", "url": "",
+	},
+	"google_analytics": {
+		"enabled": false, "utm_source": "", "utm_medium": "", "utm_term": "", "utm_content": "", "utm_campaign": "",
+	},
+}
+
+func (s *Server) registerTrackingSettings() {
+	s.registerSettings("tracking_settings", trackingSettingDefaults, nil)
+}
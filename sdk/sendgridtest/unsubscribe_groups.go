@@ -0,0 +1,71 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"strconv"
+)
+
+func (s *Server) registerUnsubscribeGroups() {
+	s.handle(http.MethodPost, "/asm/groups", s.createUnsubscribeGroup)
+	s.handle(http.MethodGet, "/asm/groups", s.listUnsubscribeGroups)
+	s.handle(http.MethodGet, "/asm/groups/{id}", s.readUnsubscribeGroup)
+	s.handle(http.MethodPatch, "/asm/groups/{id}", s.updateUnsubscribeGroup)
+	s.handle(http.MethodDelete, "/asm/groups/{id}", s.deleteUnsubscribeGroup)
+}
+
+func (s *Server) createUnsubscribeGroup(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "name") {
+		return
+	}
+
+	id := s.newID()
+	group := object{
+		"id":           id,
+		"description":  "",
+		"is_default":   false,
+		"unsubscribes": 0,
+	}
+
+	merge(group, body, "name", "description", "is_default")
+	a.collection("groups")[strconv.Itoa(id)] = group
+
+	writeJSON(w, http.StatusCreated, group)
+}
+
+func (s *Server) listUnsubscribeGroups(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+	writeJSON(w, http.StatusOK, a.list("groups"))
+}
+
+func (s *Server) readUnsubscribeGroup(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	group, ok := a.collection("groups")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) updateUnsubscribeGroup(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	group, ok := a.collection("groups")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	merge(group, body, "name", "description", "is_default")
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) deleteUnsubscribeGroup(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "groups", p["id"])
+}
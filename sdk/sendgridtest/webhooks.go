@@ -0,0 +1,281 @@
+package sendgridtest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var eventWebhookFields = []string{
+	"friendly_name", "enabled", "url", "group_resubscribe", "delivered", "group_unsubscribe", "spam_report", "bounce",
+	"deferred", "unsubscribe", "processed", "open", "click", "dropped", "oauth_client_id", "oauth_client_secret",
+	"oauth_token_url",
+}
+
+// maxEventWebhooks is the number of event webhooks an account can have.
+const maxEventWebhooks = 5
+
+func (s *Server) registerWebhooks() {
+	s.handle(http.MethodPost, "/user/webhooks/event/settings", s.createEventWebhook)
+	s.handle(http.MethodGet, "/user/webhooks/event/settings", s.readLegacyEventWebhook)
+	s.handle(http.MethodGet, "/user/webhooks/event/settings/{id}", s.readEventWebhook)
+	s.handle(http.MethodPatch, "/user/webhooks/event/settings/{id}", s.updateEventWebhook)
+	s.handle(http.MethodDelete, "/user/webhooks/event/settings/{id}", s.deleteEventWebhook)
+	s.handle(http.MethodGet, "/user/webhooks/event/settings/signed/{id}", s.readEventWebhookSigning)
+	s.handle(http.MethodPatch, "/user/webhooks/event/settings/signed/{id}", s.updateEventWebhookSigning)
+	s.handle(http.MethodPost, "/user/webhooks/event/test", s.testEventWebhook)
+
+	s.handle(http.MethodPost, "/user/webhooks/parse/settings", s.createParseWebhook)
+	s.handle(http.MethodGet, "/user/webhooks/parse/settings", s.listParseWebhooks)
+	s.handle(http.MethodGet, "/user/webhooks/parse/settings/{hostname}", s.readParseWebhook)
+	s.handle(http.MethodPatch, "/user/webhooks/parse/settings/{hostname}", s.updateParseWebhook)
+	s.handle(http.MethodPut, "/user/webhooks/parse/settings/{hostname}", s.updateParseWebhook)
+	s.handle(http.MethodDelete, "/user/webhooks/parse/settings/{hostname}", s.deleteParseWebhook)
+}
+
+func (s *Server) newEventWebhook(a *account) object {
+	webhook := object{
+		"id":              s.newUUID(),
+		"friendly_name":   "",
+		"url":             "",
+		"oauth_client_id": "",
+		"oauth_token_url": "",
+		"public_key":      "",
+	}
+
+	for _, f := range eventWebhookFields {
+		if _, set := webhook[f]; !set && f != "oauth_client_secret" {
+			webhook[f] = false
+		}
+	}
+
+	a.collection("event_webhooks")[webhook["id"].(string)] = webhook
+
+	return webhook
+}
+
+// legacyEventWebhook returns the oldest event webhook of the account, which the endpoints predating
+// multiple event webhooks act on, or nil if there is none.
+func (a *account) legacyEventWebhook() object {
+	if webhooks := a.list("event_webhooks"); len(webhooks) > 0 {
+		return webhooks[0]
+	}
+
+	return nil
+}
+
+// renderEventWebhook omits the OAuth client secret, which Sendgrid never returns, and the signing key.
+func renderEventWebhook(webhook object) object {
+	rendered := object{}
+	merge(rendered, webhook)
+	delete(rendered, "oauth_client_secret")
+	delete(rendered, "public_key")
+
+	return rendered
+}
+
+func (s *Server) createEventWebhook(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "url") {
+		return
+	}
+
+	if len(a.collection("event_webhooks")) >= maxEventWebhooks {
+		writeError(w, http.StatusBadRequest, "", "the maximum number of event webhooks has been reached")
+
+		return
+	}
+
+	webhook := s.newEventWebhook(a)
+	merge(webhook, body, eventWebhookFields...)
+
+	writeJSON(w, http.StatusCreated, renderEventWebhook(webhook))
+}
+
+func (s *Server) readLegacyEventWebhook(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+	webhook := a.legacyEventWebhook()
+	if webhook == nil {
+		webhook = object{"enabled": false, "url": ""}
+	}
+
+	writeJSON(w, http.StatusOK, renderEventWebhook(webhook))
+}
+
+func (s *Server) readEventWebhook(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	webhook, ok := a.collection("event_webhooks")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, renderEventWebhook(webhook))
+}
+
+func (s *Server) updateEventWebhook(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	webhook, ok := a.collection("event_webhooks")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	merge(webhook, body, eventWebhookFields...)
+
+	writeJSON(w, http.StatusOK, renderEventWebhook(webhook))
+}
+
+func (s *Server) deleteEventWebhook(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "event_webhooks", p["id"])
+}
+
+func (s *Server) readEventWebhookSigning(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	webhook, ok := a.collection("event_webhooks")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"id": webhook["id"], "public_key": webhook["public_key"]})
+}
+
+func (s *Server) updateEventWebhookSigning(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	webhook, ok := a.collection("event_webhooks")[p["id"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	enabled, _ := body["enabled"].(bool)
+	switch {
+	case !enabled:
+		webhook["public_key"] = ""
+	case webhook["public_key"] == "":
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "", err.Error())
+
+			return
+		}
+
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "", err.Error())
+
+			return
+		}
+
+		webhook["public_key"] = base64.StdEncoding.EncodeToString(der)
+	}
+
+	writeJSON(w, http.StatusOK, object{"id": webhook["id"], "public_key": webhook["public_key"]})
+}
+
+// testEvent is the payload posted to test an event webhook.
+const testEvent = `[{"email":"example@test.com","event":"processed","sg_event_id":"test","timestamp":0}]`
+
+// testEventWebhook really posts a test event to the URL of the request, so that tests can
+// stand up a receiver and check how its failures are reported.
+func (s *Server) testEventWebhook(w http.ResponseWriter, r *http.Request, _ *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "url") {
+		return
+	}
+
+	url, _ := body["url"].(string)
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	resp, err := client.Post(url, "application/json", bytes.NewBufferString(testEvent)) //nolint:noctx
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "url", fmt.Sprintf("unable to post the test event: %v", err))
+
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		writeError(w, http.StatusBadRequest, "url",
+			fmt.Sprintf("the test event was rejected with status %s", resp.Status))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createParseWebhook(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "hostname", "url") {
+		return
+	}
+
+	hostname, _ := body["hostname"].(string)
+
+	webhooks := a.collection("parse_webhooks")
+	if _, exists := webhooks[hostname]; exists {
+		writeError(w, http.StatusBadRequest, "hostname", "A parse setting for this hostname already exists.")
+
+		return
+	}
+
+	webhook := object{"spam_check": false, "send_raw": false}
+	merge(webhook, body, "hostname", "url", "spam_check", "send_raw")
+	webhooks[hostname] = webhook
+
+	writeJSON(w, http.StatusCreated, webhook)
+}
+
+func (s *Server) listParseWebhooks(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+	writeJSON(w, http.StatusOK, object{"result": a.list("parse_webhooks")})
+}
+
+func (s *Server) readParseWebhook(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	webhook, ok := a.collection("parse_webhooks")[p["hostname"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+func (s *Server) updateParseWebhook(w http.ResponseWriter, r *http.Request, a *account, p params) {
+	webhook, ok := a.collection("parse_webhooks")[p["hostname"]]
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	merge(webhook, body, "url", "spam_check", "send_raw")
+
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+func (s *Server) deleteParseWebhook(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+	deleteObject(w, a, "parse_webhooks", p["hostname"])
+}
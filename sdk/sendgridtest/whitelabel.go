@@ -0,0 +1,332 @@
+package sendgridtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func (s *Server) registerWhitelabel() {
+	s.handle(http.MethodGet, "/whitelabel/domains/subuser", s.readSubuserWhitelabel("domains"))
+	s.handle(http.MethodDelete, "/whitelabel/domains/subuser", s.disassociateSubuserWhitelabel("domains"))
+	s.handle(http.MethodPost, "/whitelabel/domains", s.createDomain)
+	s.handle(http.MethodGet, "/whitelabel/domains", s.listWhitelabel("domains"))
+	s.handle(http.MethodGet, "/whitelabel/domains/{id}", s.readWhitelabel("domains"))
+	s.handle(http.MethodPatch, "/whitelabel/domains/{id}", s.updateWhitelabel("domains", "default", "custom_spf"))
+	s.handle(http.MethodDelete, "/whitelabel/domains/{id}", s.deleteWhitelabel("domains"))
+	s.handle(http.MethodPost, "/whitelabel/domains/{id}/validate", s.validateWhitelabel("domains"))
+	s.handle(http.MethodPost, "/whitelabel/domains/{id}/subuser", s.associateSubuserWhitelabel("domains"))
+
+	s.handle(http.MethodGet, "/whitelabel/links/subuser", s.readSubuserWhitelabel("links"))
+	s.handle(http.MethodDelete, "/whitelabel/links/subuser", s.disassociateSubuserWhitelabel("links"))
+	s.handle(http.MethodPost, "/whitelabel/links", s.createLink)
+	s.handle(http.MethodGet, "/whitelabel/links", s.listWhitelabel("links"))
+	s.handle(http.MethodGet, "/whitelabel/links/{id}", s.readWhitelabel("links"))
+	s.handle(http.MethodPatch, "/whitelabel/links/{id}", s.updateWhitelabel("links", "default"))
+	s.handle(http.MethodDelete, "/whitelabel/links/{id}", s.deleteWhitelabel("links"))
+	s.handle(http.MethodPost, "/whitelabel/links/{id}/validate", s.validateWhitelabel("links"))
+	s.handle(http.MethodPost, "/whitelabel/links/{id}/subuser", s.associateSubuserWhitelabel("links"))
+
+	s.handle(http.MethodPost, "/whitelabel/reverse_dns", s.createReverseDNS)
+	s.handle(http.MethodGet, "/whitelabel/reverse_dns", s.listWhitelabel("reverse_dns"))
+	s.handle(http.MethodGet, "/whitelabel/reverse_dns/{id}", s.readWhitelabel("reverse_dns"))
+	s.handle(http.MethodDelete, "/whitelabel/reverse_dns/{id}", s.deleteWhitelabel("reverse_dns"))
+	s.handle(http.MethodPost, "/whitelabel/reverse_dns/{id}/validate", s.validateWhitelabel("reverse_dns"))
+}
+
+func dnsRecord(recordType, host, data string) object {
+	return object{"valid": false, "type": recordType, "host": host, "data": data}
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "domain") {
+		return
+	}
+
+	id := s.newID()
+	domain, _ := body["domain"].(string)
+
+	subdomain, _ := body["subdomain"].(string)
+	if subdomain == "" {
+		subdomain = "em"
+	}
+
+	selector, _ := body["custom_dkim_selector"].(string)
+	if selector == "" {
+		selector = "s"
+	}
+
+	sendgridHost := fmt.Sprintf("u%d.wl.sendgrid.net", id)
+	dns := object{}
+
+	if automatic, _ := body["automatic_security"].(bool); automatic {
+		dns["mail_cname"] = dnsRecord("cname", subdomain+"."+domain, sendgridHost)
+		dns["dkim1"] = dnsRecord("cname", selector+"1._domainkey."+domain, selector+"1.domainkey."+sendgridHost)
+		dns["dkim2"] = dnsRecord("cname", selector+"2._domainkey."+domain, selector+"2.domainkey."+sendgridHost)
+	} else {
+		dns["mail_server"] = dnsRecord("mx", subdomain+"."+domain, "mx.sendgrid.net.")
+		dns["subdomain_spf"] = dnsRecord("txt", subdomain+"."+domain, "v=spf1 include:sendgrid.net ~all")
+		dns["dkim"] = dnsRecord("txt", selector+"._domainkey."+domain, "k=rsa; t=s; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQC")
+	}
+
+	auth := object{
+		"id":                   id,
+		"user_id":              1,
+		"domain":               domain,
+		"subdomain":            subdomain,
+		"username":             "sendgridtest",
+		"ips":                  []string{},
+		"custom_spf":           false,
+		"default":              false,
+		"legacy":               false,
+		"automatic_security":   false,
+		"custom_dkim_selector": selector,
+		"valid":                false,
+		"dns":                  dns,
+	}
+
+	merge(auth, body, "username", "ips", "custom_spf", "default", "automatic_security")
+	a.collection("domains")[strconv.Itoa(id)] = auth
+
+	writeJSON(w, http.StatusCreated, auth)
+}
+
+func (s *Server) createLink(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "domain") {
+		return
+	}
+
+	id := s.newID()
+	domain, _ := body["domain"].(string)
+
+	subdomain, _ := body["subdomain"].(string)
+	if subdomain == "" {
+		subdomain = "url"
+	}
+
+	link := object{
+		"id":        id,
+		"user_id":   1,
+		"domain":    domain,
+		"subdomain": subdomain,
+		"username":  "sendgridtest",
+		"default":   false,
+		"legacy":    false,
+		"valid":     false,
+		"dns": object{
+			"domain_cname": dnsRecord("cname", subdomain+"."+domain, "sendgrid.net"),
+			"owner_cname":  dnsRecord("cname", fmt.Sprintf("%d.%s", id, domain), "sendgrid.net"),
+		},
+	}
+
+	merge(link, body, "default")
+	a.collection("links")[strconv.Itoa(id)] = link
+
+	writeJSON(w, http.StatusCreated, link)
+}
+
+func (s *Server) createReverseDNS(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+	body, ok := decode(w, r)
+	if !ok || !require(w, body, "ip", "domain") {
+		return
+	}
+
+	ip, _ := body["ip"].(string)
+	if _, ok := a.collection("ips")[ip]; !ok {
+		writeError(w, http.StatusNotFound, "ip", "ip not found")
+
+		return
+	}
+
+	for _, rdns := range a.collection("reverse_dns") {
+		if rdns["ip"] == ip {
+			writeError(w, http.StatusBadRequest, "ip", "a reverse DNS already exists for this ip")
+
+			return
+		}
+	}
+
+	id := s.newID()
+	domain, _ := body["domain"].(string)
+
+	subdomain, _ := body["subdomain"].(string)
+	if subdomain == "" {
+		subdomain = "o1"
+	}
+
+	host := subdomain + "." + domain
+	rdns := object{
+		"id":                         id,
+		"ip":                         ip,
+		"rdns":                       host,
+		"users":                      []object{{"username": "sendgridtest", "user_id": 1}},
+		"domain":                     domain,
+		"subdomain":                  subdomain,
+		"valid":                      false,
+		"legacy":                     false,
+		"last_validation_attempt_at": 0,
+		"a_record":                   dnsRecord("a", host, ip),
+	}
+	a.collection("reverse_dns")[strconv.Itoa(id)] = rdns
+
+	writeJSON(w, http.StatusCreated, rdns)
+}
+
+func (s *Server) listWhitelabel(collection string) handlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, a *account, _ params) {
+		writeJSON(w, http.StatusOK, a.list(collection))
+	}
+}
+
+func (s *Server) readWhitelabel(collection string) handlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+		o, ok := a.collection(collection)[p["id"]]
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) updateWhitelabel(collection string, fields ...string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, a *account, p params) {
+		o, ok := a.collection(collection)[p["id"]]
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		body, ok := decode(w, r)
+		if !ok {
+			return
+		}
+
+		merge(o, body, fields...)
+
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) deleteWhitelabel(collection string) handlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+		deleteObject(w, a, collection, p["id"])
+	}
+}
+
+// PublishedDomain is a domain whose DNS records a Server deems published, since no DNS is reachable from it.
+// The records of the domains, links and reverse DNS under it become valid on their second validation,
+// once propagated, whereas the records of any other domain always fail validation.
+const PublishedDomain = "published.example.org"
+
+// validateWhitelabel checks the DNS records of a domain, link or reverse DNS.
+func (s *Server) validateWhitelabel(collection string) handlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, a *account, p params) {
+		o, ok := a.collection(collection)[p["id"]]
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		records, ok := o["dns"].(object)
+		if !ok {
+			records = object{"a_record": o["a_record"]}
+		}
+
+		if domain, _ := o["domain"].(string); strings.HasSuffix("."+domain, "."+PublishedDomain) {
+			attempts := a.collection("validation_attempts")
+			key := collection + "/" + p["id"]
+
+			if _, propagated := attempts[key]; propagated {
+				o["valid"] = true
+
+				for _, record := range records {
+					record.(object)["valid"] = true
+				}
+			}
+
+			attempts[key] = object{}
+		}
+
+		results := object{}
+
+		for name, record := range records {
+			rec := record.(object)
+			result := object{"valid": rec["valid"], "reason": nil}
+
+			if rec["valid"] != true {
+				result["reason"] = fmt.Sprintf("Expected %s record for %q but found nothing.", rec["type"], rec["host"])
+			}
+
+			results[name] = result
+		}
+
+		writeJSON(w, http.StatusOK, object{
+			"id":                 o["id"],
+			"valid":              o["valid"],
+			"validation_results": results,
+		})
+	}
+}
+
+// associateSubuserWhitelabel shares a domain or link of the parent account with a subuser,
+// replacing the one it was associated with, if any.
+func (s *Server) associateSubuserWhitelabel(collection string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, a *account, p params) {
+		o, ok := a.collection(collection)[p["id"]]
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		body, ok := decode(w, r)
+		if !ok || !require(w, body, "username") {
+			return
+		}
+
+		username, _ := body["username"].(string)
+		if _, ok := a.collection("subusers")[username]; !ok {
+			writeError(w, http.StatusBadRequest, "username", "subuser not found")
+
+			return
+		}
+
+		a.collection(collection + "_subusers")[username] = object{"id": p["id"]}
+
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) readSubuserWhitelabel(collection string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+		association, ok := a.collection(collection + "_subusers")[r.URL.Query().Get("username")]
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		o, ok := a.collection(collection)[association["id"].(string)]
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) disassociateSubuserWhitelabel(collection string) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, a *account, _ params) {
+		deleteObject(w, a, collection+"_subusers", r.URL.Query().Get("username"))
+	}
+}
@@ -49,7 +49,7 @@ func (c Client) CreateSSOCertificate(
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOCertificate, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingSSOCertificate),
 		}
 	}
 
@@ -65,7 +65,7 @@ func (c Client) ReadSSOCertificate(ctx context.Context, id string) (*SSOCertific
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", fmt.Sprintf("/sso/certificates/%s", id))
+	respBody, statusCode, err := c.Get(ctx, "GET", fmt.Sprintf("/sso/certificates/%s", id))
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -73,6 +73,13 @@ func (c Client) ReadSSOCertificate(ctx context.Context, id string) (*SSOCertific
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseSSOCertificate(respBody)
 }
 
@@ -118,7 +125,7 @@ func (c Client) UpdateSSOCertificate(
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOCertificate, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedUpdatingSSOCertificate),
 		}
 	}
 
@@ -134,10 +141,18 @@ func (c Client) DeleteSSOCertificate(ctx context.Context, id string) (bool, Requ
 		}
 	}
 
-	if _, statusCode, err := c.Get(ctx, "DELETE", fmt.Sprintf("/sso/certificates/%s", id)); statusCode > 299 || err != nil {
+	respBody, statusCode, err := c.Get(ctx, "DELETE", fmt.Sprintf("/sso/certificates/%s", id))
+	if err != nil {
 		return false, RequestError{
 			StatusCode: http.StatusInternalServerError,
-			Err:        fmt.Errorf("failed deleting SSO integration: %w", err),
+			Err:        fmt.Errorf("failed deleting SSO certificate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
 		}
 	}
 
@@ -150,13 +165,20 @@ func (c Client) DeleteSSOCertificate(ctx context.Context, id string) (bool, Requ
 // ListSSOCertificates retrieves all existing SSO certificates.
 func (c Client) ListSSOCertificates(ctx context.Context) ([]*SSOCertificate, RequestError) {
 	respBody, statusCode, err := c.Get(ctx, "GET", "/sso/certificates")
-	if err != nil || statusCode >= 300 {
+	if err != nil {
 		return nil, RequestError{
 			StatusCode: statusCode,
 			Err:        err,
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseSSOCertificates(respBody)
 }
 
@@ -53,7 +53,7 @@ func (c Client) CreateSSOIntegration(
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOIntegration, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingSSOIntegration),
 		}
 	}
 
@@ -69,7 +69,7 @@ func (c Client) ReadSSOIntegration(id string, ctx context.Context) (*SSOIntegrat
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", fmt.Sprintf("/sso/integrations/%s", id))
+	respBody, statusCode, err := c.Get(ctx, "GET", fmt.Sprintf("/sso/integrations/%s", id))
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -77,6 +77,13 @@ func (c Client) ReadSSOIntegration(id string, ctx context.Context) (*SSOIntegrat
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseSSOIntegration(respBody)
 }
 
@@ -121,7 +128,7 @@ func (c Client) UpdateSSOIntegration(
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOIntegration, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedUpdatingSSOIntegration),
 		}
 	}
 
@@ -137,13 +144,21 @@ func (c Client) DeleteSSOIntegration(ctx context.Context, id string) (bool, Requ
 		}
 	}
 
-	if _, statusCode, err := c.Get(ctx, "DELETE", fmt.Sprintf("/sso/integrations/%s", id)); statusCode > 299 || err != nil {
+	respBody, statusCode, err := c.Get(ctx, "DELETE", fmt.Sprintf("/sso/integrations/%s", id))
+	if err != nil {
 		return false, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to delete SSO integration: %w", err),
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return true, RequestError{
 		StatusCode: http.StatusOK,
 		Err:        nil,
@@ -153,13 +168,20 @@ func (c Client) DeleteSSOIntegration(ctx context.Context, id string) (bool, Requ
 // ListSSOIntegrations returns a list of SSO integrations.
 func (c Client) ListSSOIntegrations(ctx context.Context) ([]*SSOIntegration, RequestError) {
 	respBody, statusCode, err := c.Get(ctx, "GET", "/sso/integrations")
-	if err != nil || statusCode >= 300 {
+	if err != nil {
 		return nil, RequestError{
 			StatusCode: statusCode,
 			Err:        err,
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseSSOIntegrations(respBody)
 }
 
@@ -96,7 +96,7 @@ func (c *Client) CreateSubuser(ctx context.Context, username, email, password st
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSubUser, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingSubUser),
 		}
 	}
 
@@ -119,7 +119,22 @@ func (c *Client) ReadSubUser(ctx context.Context, username string) ([]SubUser, R
 		}
 	}
 
-	return parseSubUsers(respBody)
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	subUsers, requestErr := parseSubUsers(respBody)
+	if requestErr.Err == nil && len(subUsers) == 0 {
+		return nil, RequestError{
+			StatusCode: http.StatusNotFound,
+			Err:        fmt.Errorf("%w: subuser %s", ErrNotFound, username),
+		}
+	}
+
+	return subUsers, requestErr
 }
 
 // UpdateSubuser enables/disables a subuser.
@@ -138,15 +153,14 @@ func (c *Client) UpdateSubuser(ctx context.Context, username string, disabled bo
 		}
 	}
 
-	var body subUserErrors
-	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+	if statusCode >= http.StatusMultipleChoices {
 		return false, RequestError{
-			StatusCode: http.StatusInternalServerError,
-			Err:        fmt.Errorf("failed updating subUser: %w", err),
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
 		}
 	}
 
-	return len(body.Errors) == 0, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
 }
 
 func (c *Client) UpdateSubuserIPs(ctx context.Context, username string, ips []string) RequestError {
@@ -154,7 +168,7 @@ func (c *Client) UpdateSubuserIPs(ctx context.Context, username string, ips []st
 		return RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
 	}
 
-	_, statusCode, err := c.Post(ctx, "PUT", "/subusers/"+username+"/ips", ips)
+	respBody, statusCode, err := c.Post(ctx, "PUT", "/subusers/"+username+"/ips", ips)
 	if err != nil {
 		return RequestError{
 			StatusCode: statusCode,
@@ -162,6 +176,13 @@ func (c *Client) UpdateSubuserIPs(ctx context.Context, username string, ips []st
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return RequestError{StatusCode: http.StatusOK, Err: nil}
 }
 
@@ -182,7 +203,7 @@ func (c *Client) DeleteSubuser(ctx context.Context, username string) (bool, Requ
 	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
 		return false, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w: statusCode: %d, respBody: %s", err, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedDeletingSubUser),
 		}
 	}
 
@@ -194,14 +215,10 @@ func (c *Client) UpdateSubuserPassword(ctx context.Context, username string, old
 		return RequestError{StatusCode: http.StatusBadRequest, Err: ErrSubUserPassword}
 	}
 
-	origOnBehalfOf := c.OnBehalfOf
-	c.OnBehalfOf = username
-	_, statusCode, err := c.Post(ctx, "PUT", "/user/password", UpdateSubUserPassword{
+	respBody, statusCode, err := c.Post(WithOnBehalfOf(ctx, username), "PUT", "/user/password", UpdateSubUserPassword{
 		NewPassword: newPassword,
 		OldPassword: oldPassword,
 	})
-	c.OnBehalfOf = origOnBehalfOf
-
 	if err != nil {
 		return RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -212,7 +229,7 @@ func (c *Client) UpdateSubuserPassword(ctx context.Context, username string, old
 	if statusCode >= http.StatusMultipleChoices {
 		return RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w: statusCode: %d", err, statusCode),
+			Err:        newAPIError(statusCode, respBody, nil),
 		}
 	}
 
@@ -0,0 +1,104 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SubuserCredits is the credit allocation of a subuser, capping the number of emails it can send.
+// Type is one of unlimited, recurring (Total credits every ResetFrequency: daily, weekly or monthly)
+// and nonrecurring (Total credits once).
+type SubuserCredits struct {
+	Type           string `json:"type"`
+	ResetFrequency string `json:"reset_frequency,omitempty"` //nolint:tagliatelle
+	Total          int    `json:"total,omitempty"`
+	Remain         int    `json:"remain,omitempty"`
+	Used           int    `json:"used,omitempty"`
+}
+
+// subuserCreditsUpdate is the body updating SubuserCredits, which omits the read-only remaining and used credits.
+// Total is only omitted for unlimited credits: a total of 0 leaves the subuser without credits.
+type subuserCreditsUpdate struct {
+	Type           string `json:"type"`
+	ResetFrequency string `json:"reset_frequency,omitempty"` //nolint:tagliatelle
+	Total          *int   `json:"total,omitempty"`
+}
+
+func parseSubuserCredits(respBody string) (*SubuserCredits, RequestError) {
+	var body SubuserCredits
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing subuser credits: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadSubuserCredits retrieves the credit allocation of a subuser.
+func (c *Client) ReadSubuserCredits(ctx context.Context, username string) (*SubuserCredits, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/subusers/"+url.PathEscape(username)+"/credits")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading subuser credits: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseSubuserCredits(respBody)
+}
+
+// UpdateSubuserCredits changes the credit allocation of a subuser and returns it.
+func (c *Client) UpdateSubuserCredits(
+	ctx context.Context,
+	username string,
+	credits SubuserCredits,
+) (*SubuserCredits, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	if credits.Type == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSubuserCreditsTypeRequired}
+	}
+
+	update := subuserCreditsUpdate{
+		Type:           credits.Type,
+		ResetFrequency: credits.ResetFrequency,
+	}
+	if credits.Type != "unlimited" {
+		update.Total = &credits.Total
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "PUT", "/subusers/"+url.PathEscape(username)+"/credits", update)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating subuser credits: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedUpdatingSubuserCredits),
+		}
+	}
+
+	return parseSubuserCredits(respBody)
+}
@@ -0,0 +1,129 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sendgrid/rest"
+)
+
+// SubuserMonitor is the monitor of a subuser: a sample of the emails it sends, one every Frequency emails,
+// is copied to Email.
+type SubuserMonitor struct {
+	Email     string `json:"email"`
+	Frequency int    `json:"frequency"`
+}
+
+func parseSubuserMonitor(respBody string) (*SubuserMonitor, RequestError) {
+	var body SubuserMonitor
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing subuser monitor: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func (c *Client) writeSubuserMonitor(
+	ctx context.Context,
+	method rest.Method,
+	username string,
+	monitor SubuserMonitor,
+	failed error,
+) (*SubuserMonitor, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	if monitor.Email == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrEmailRequired}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, method, "/subusers/"+url.PathEscape(username)+"/monitor", monitor)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("%s: %w", failed, err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, failed),
+		}
+	}
+
+	return parseSubuserMonitor(respBody)
+}
+
+// CreateSubuserMonitor starts monitoring a subuser and returns its SubuserMonitor.
+func (c *Client) CreateSubuserMonitor(
+	ctx context.Context,
+	username string,
+	monitor SubuserMonitor,
+) (*SubuserMonitor, RequestError) {
+	return c.writeSubuserMonitor(ctx, "POST", username, monitor, ErrFailedCreatingSubuserMonitor)
+}
+
+// ReadSubuserMonitor retrieves the monitor of a subuser.
+func (c *Client) ReadSubuserMonitor(ctx context.Context, username string) (*SubuserMonitor, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/subusers/"+url.PathEscape(username)+"/monitor")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading subuser monitor: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseSubuserMonitor(respBody)
+}
+
+// UpdateSubuserMonitor changes the monitor of a subuser and returns it.
+func (c *Client) UpdateSubuserMonitor(
+	ctx context.Context,
+	username string,
+	monitor SubuserMonitor,
+) (*SubuserMonitor, RequestError) {
+	return c.writeSubuserMonitor(ctx, "PUT", username, monitor, ErrFailedUpdatingSubuserMonitor)
+}
+
+// DeleteSubuserMonitor stops monitoring a subuser.
+func (c *Client) DeleteSubuserMonitor(ctx context.Context, username string) RequestError {
+	if username == "" {
+		return RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "DELETE", "/subusers/"+url.PathEscape(username)+"/monitor")
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting subuser monitor: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedDeletingSubuserMonitor),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
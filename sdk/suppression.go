@@ -0,0 +1,413 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// suppressionsPageSize is the largest page of suppressions Sendgrid returns at once.
+const suppressionsPageSize = 500
+
+// GlobalSuppression is a recipient unsubscribed from every email of the account.
+type GlobalSuppression struct {
+	Email   string `json:"email"`
+	Created int64  `json:"created"`
+}
+
+// Suppression is a recipient Sendgrid stopped sending emails to, after it bounced, blocked,
+// reported them as spam or because its email is invalid. Reason, Status and IP are only set
+// by the lists providing them.
+type Suppression struct {
+	Email   string `json:"email"`
+	Created int64  `json:"created"`
+	Reason  string `json:"reason,omitempty"`
+	Status  string `json:"status,omitempty"`
+	IP      string `json:"ip,omitempty"`
+}
+
+// SuppressionFilter restricts the suppressions read to the ones created between StartTime and EndTime,
+// as unix timestamps, and to Email. Zero values don't filter.
+type SuppressionFilter struct {
+	StartTime int64
+	EndTime   int64
+	Email     string
+}
+
+func (f SuppressionFilter) query() string {
+	query := url.Values{}
+
+	if f.StartTime != 0 {
+		query.Set("start_time", strconv.FormatInt(f.StartTime, 10))
+	}
+
+	if f.EndTime != 0 {
+		query.Set("end_time", strconv.FormatInt(f.EndTime, 10))
+	}
+
+	if f.Email != "" {
+		query.Set("email", f.Email)
+	}
+
+	return query.Encode()
+}
+
+// recipientEmails is the body adding recipients to a suppression list.
+type recipientEmails struct {
+	RecipientEmails []string `json:"recipient_emails"` //nolint:tagliatelle
+}
+
+func parseRecipientEmails(respBody string) ([]string, RequestError) {
+	var body recipientEmails
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing suppressions: %w", err),
+		}
+	}
+
+	return body.RecipientEmails, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func parseEmails(respBody string) ([]string, RequestError) {
+	var body []string
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing suppressions: %w", err),
+		}
+	}
+
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AddGlobalSuppressions unsubscribes emails from every email of the account, in a single request,
+// and returns the emails added.
+func (c *Client) AddGlobalSuppressions(ctx context.Context, emails []string) ([]string, RequestError) {
+	if len(emails) == 0 {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/asm/suppressions/global", recipientEmails{
+		RecipientEmails: emails,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed adding global suppressions: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedAddingSuppressions),
+		}
+	}
+
+	return parseRecipientEmails(respBody)
+}
+
+// ReadGlobalSuppression tells whether email is unsubscribed from every email of the account.
+func (c *Client) ReadGlobalSuppression(ctx context.Context, email string) (bool, RequestError) {
+	if email == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/asm/suppressions/global/"+url.PathEscape(email))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	// The recipient email is only returned when it is suppressed.
+	var body struct {
+		RecipientEmail string `json:"recipient_email"` //nolint:tagliatelle
+	}
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing global suppression: %w", err),
+		}
+	}
+
+	return body.RecipientEmail != "", RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadGlobalSuppressions retrieves every recipient unsubscribed from every email of the account.
+func (c *Client) ReadGlobalSuppressions(ctx context.Context) ([]GlobalSuppression, RequestError) {
+	var result []GlobalSuppression
+
+	for offset := 0; ; offset += suppressionsPageSize {
+		endpoint := fmt.Sprintf("/suppression/unsubscribes?limit=%d&offset=%d", suppressionsPageSize, offset)
+
+		respBody, statusCode, err := c.Get(ctx, "GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode: statusCode,
+				Err:        newAPIError(statusCode, respBody, nil),
+			}
+		}
+
+		var suppressions []GlobalSuppression
+		if err := json.Unmarshal([]byte(respBody), &suppressions); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing global suppressions: %w", err),
+			}
+		}
+
+		result = append(result, suppressions...)
+
+		if len(suppressions) < suppressionsPageSize {
+			return result, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
+
+// DeleteGlobalSuppression resubscribes email to the emails of the account.
+func (c *Client) DeleteGlobalSuppression(ctx context.Context, email string) (bool, RequestError) {
+	if email == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "DELETE", "/asm/suppressions/global/"+url.PathEscape(email))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedDeletingSuppression),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AddGroupSuppressions unsubscribes emails from an UnsubscribeGroup, in a single request,
+// and returns the emails added.
+func (c *Client) AddGroupSuppressions(ctx context.Context, groupID string, emails []string) ([]string, RequestError) {
+	if groupID == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUnsubscribeGroupIDRequired,
+		}
+	}
+
+	if len(emails) == 0 {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/asm/groups/"+url.PathEscape(groupID)+"/suppressions",
+		recipientEmails{RecipientEmails: emails})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed adding group suppressions: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedAddingSuppressions),
+		}
+	}
+
+	return parseRecipientEmails(respBody)
+}
+
+// ReadGroupSuppressions retrieves every email unsubscribed from an UnsubscribeGroup.
+func (c *Client) ReadGroupSuppressions(ctx context.Context, groupID string) ([]string, RequestError) {
+	if groupID == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUnsubscribeGroupIDRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/asm/groups/"+url.PathEscape(groupID)+"/suppressions")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseEmails(respBody)
+}
+
+// SearchGroupSuppressions returns which of emails are unsubscribed from an UnsubscribeGroup.
+func (c *Client) SearchGroupSuppressions(ctx context.Context, groupID string, emails []string) ([]string, RequestError) {
+	if groupID == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUnsubscribeGroupIDRequired,
+		}
+	}
+
+	if len(emails) == 0 {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/asm/groups/"+url.PathEscape(groupID)+"/suppressions/search",
+		recipientEmails{RecipientEmails: emails})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed searching group suppressions: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseEmails(respBody)
+}
+
+// DeleteGroupSuppression resubscribes email to an UnsubscribeGroup.
+func (c *Client) DeleteGroupSuppression(ctx context.Context, groupID, email string) (bool, RequestError) {
+	if groupID == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUnsubscribeGroupIDRequired,
+		}
+	}
+
+	if email == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	endpoint := "/asm/groups/" + url.PathEscape(groupID) + "/suppressions/" + url.PathEscape(email)
+
+	respBody, statusCode, err := c.Get(ctx, "DELETE", endpoint)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedDeletingSuppression),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// readSuppressions retrieves every suppression of list matching filter, a page at a time.
+func (c *Client) readSuppressions(ctx context.Context, list string, filter SuppressionFilter) ([]Suppression, RequestError) {
+	result := []Suppression{}
+
+	for offset := 0; ; offset += suppressionsPageSize {
+		endpoint := fmt.Sprintf("/suppression/%s?limit=%d&offset=%d", list, suppressionsPageSize, offset)
+		if query := filter.query(); query != "" {
+			endpoint += "&" + query
+		}
+
+		respBody, statusCode, err := c.Get(ctx, "GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode: statusCode,
+				Err:        newAPIError(statusCode, respBody, nil),
+			}
+		}
+
+		var suppressions []Suppression
+		if err := json.Unmarshal([]byte(respBody), &suppressions); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing %s: %w", list, err),
+			}
+		}
+
+		result = append(result, suppressions...)
+
+		if len(suppressions) < suppressionsPageSize {
+			return result, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
+
+// ReadBounces retrieves the recipients whose emails bounced, matching filter.
+func (c *Client) ReadBounces(ctx context.Context, filter SuppressionFilter) ([]Suppression, RequestError) {
+	return c.readSuppressions(ctx, "bounces", filter)
+}
+
+// ReadBlocks retrieves the recipients whose server blocked emails, matching filter.
+func (c *Client) ReadBlocks(ctx context.Context, filter SuppressionFilter) ([]Suppression, RequestError) {
+	return c.readSuppressions(ctx, "blocks", filter)
+}
+
+// ReadSpamReports retrieves the recipients who reported emails as spam, matching filter.
+func (c *Client) ReadSpamReports(ctx context.Context, filter SuppressionFilter) ([]Suppression, RequestError) {
+	return c.readSuppressions(ctx, "spam_reports", filter)
+}
+
+// ReadInvalidEmails retrieves the recipients whose email is invalid, matching filter.
+func (c *Client) ReadInvalidEmails(ctx context.Context, filter SuppressionFilter) ([]Suppression, RequestError) {
+	return c.readSuppressions(ctx, "invalid_emails", filter)
+}
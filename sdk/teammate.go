@@ -0,0 +1,416 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// teammatesPageSize is the largest page of teammates Sendgrid returns at once.
+const teammatesPageSize = 500
+
+// Teammate is a Sendgrid teammate, a user with access to the account.
+// The SSO fields are only used with teammates signing in through an SSO integration.
+type Teammate struct {
+	Username                   string                  `json:"username,omitempty"`
+	Email                      string                  `json:"email"`
+	FirstName                  string                  `json:"first_name,omitempty"` //nolint:tagliatelle
+	LastName                   string                  `json:"last_name,omitempty"`  //nolint:tagliatelle
+	UserType                   string                  `json:"user_type,omitempty"`  //nolint:tagliatelle
+	IsAdmin                    bool                    `json:"is_admin"`             //nolint:tagliatelle
+	IsSSO                      bool                    `json:"is_sso,omitempty"`     //nolint:tagliatelle
+	Scopes                     []string                `json:"scopes,omitempty"`
+	HasRestrictedSubuserAccess bool                    `json:"has_restricted_subuser_access"` //nolint:tagliatelle
+	SubuserAccess              []TeammateSubuserAccess `json:"subuser_access,omitempty"`      //nolint:tagliatelle
+}
+
+// TeammateSubuserAccess is the access of an SSO teammate to a subuser.
+// PermissionType is either admin, granting every scope on the subuser, or restricted to Scopes.
+type TeammateSubuserAccess struct {
+	ID             int32    `json:"id"`
+	Username       string   `json:"username,omitempty"`
+	PermissionType string   `json:"permission_type"` //nolint:tagliatelle
+	Scopes         []string `json:"scopes,omitempty"`
+}
+
+// TeammateSubuserAccesses lists the subusers an SSO teammate has access to.
+type TeammateSubuserAccesses struct {
+	HasRestrictedSubuserAccess bool                    `json:"has_restricted_subuser_access"` //nolint:tagliatelle
+	SubuserAccess              []TeammateSubuserAccess `json:"subuser_access"`                //nolint:tagliatelle
+}
+
+// PendingTeammate is an invitation sent to a teammate which wasn't accepted yet.
+type PendingTeammate struct {
+	Token          string   `json:"token"`
+	Email          string   `json:"email"`
+	IsAdmin        bool     `json:"is_admin"` //nolint:tagliatelle
+	Scopes         []string `json:"scopes"`
+	ExpirationDate int64    `json:"expiration_date,omitempty"` //nolint:tagliatelle
+}
+
+// teammatePermissions is the body inviting a teammate or updating its permissions.
+type teammatePermissions struct {
+	Email   string   `json:"email,omitempty"`
+	IsAdmin bool     `json:"is_admin"` //nolint:tagliatelle
+	Scopes  []string `json:"scopes"`
+}
+
+func newTeammatePermissions(email string, isAdmin bool, scopes []string) teammatePermissions {
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	return teammatePermissions{Email: email, IsAdmin: isAdmin, Scopes: scopes}
+}
+
+type teammates struct {
+	Result []Teammate `json:"result"`
+}
+
+type pendingTeammates struct {
+	Result []PendingTeammate `json:"result"`
+}
+
+func parseTeammate(respBody string) (*Teammate, RequestError) {
+	var body Teammate
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing teammate: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateTeammate invites a teammate by email and returns the pending invitation.
+// The teammate picks its username and password when accepting the invitation.
+func (c *Client) CreateTeammate(
+	ctx context.Context,
+	email string,
+	isAdmin bool,
+	scopes []string,
+) (*PendingTeammate, RequestError) {
+	if email == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTeammateEmailRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/teammates", newTeammatePermissions(email, isAdmin, scopes))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed inviting teammate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingTeammate),
+		}
+	}
+
+	var body PendingTeammate
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing teammate invitation: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateSSOTeammate creates a teammate signing in through SSO and returns it.
+// Its username is its email, no invitation is sent.
+func (c *Client) CreateSSOTeammate(ctx context.Context, teammate *Teammate) (*Teammate, RequestError) {
+	if teammate.Email == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTeammateEmailRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "POST", "/sso/teammates", teammate)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating SSO teammate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingTeammate),
+		}
+	}
+
+	return parseTeammate(respBody)
+}
+
+// ReadTeammate retrieves a teammate by username and returns it.
+func (c *Client) ReadTeammate(ctx context.Context, username string) (*Teammate, RequestError) {
+	if username == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTeammateUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Get(ctx, "GET", "/teammates/"+url.PathEscape(username))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	return parseTeammate(respBody)
+}
+
+// ReadTeammates retrieves all the teammates of the account, excluding pending invitations.
+func (c *Client) ReadTeammates(ctx context.Context) ([]Teammate, RequestError) {
+	var result []Teammate
+
+	for offset := 0; ; offset += teammatesPageSize {
+		endpoint := fmt.Sprintf("/teammates?limit=%d&offset=%d", teammatesPageSize, offset)
+
+		respBody, statusCode, err := c.Get(ctx, "GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode: statusCode,
+				Err:        newAPIError(statusCode, respBody, nil),
+			}
+		}
+
+		var body teammates
+		if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing teammates: %w", err),
+			}
+		}
+
+		result = append(result, body.Result...)
+
+		if len(body.Result) < teammatesPageSize {
+			return result, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
+
+// ReadPendingTeammates retrieves the invitations which weren't accepted yet.
+func (c *Client) ReadPendingTeammates(ctx context.Context) ([]PendingTeammate, RequestError) {
+	respBody, statusCode, err := c.Get(ctx, "GET", "/teammates/pending")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
+	var body pendingTeammates
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing pending teammates: %w", err),
+		}
+	}
+
+	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadTeammateSubuserAccess retrieves the subusers an SSO teammate has access to.
+func (c *Client) ReadTeammateSubuserAccess(ctx context.Context, username string) (*TeammateSubuserAccesses, RequestError) {
+	if username == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTeammateUsernameRequired,
+		}
+	}
+
+	var result TeammateSubuserAccesses
+
+	afterSubuserID := int32(0)
+
+	for {
+		endpoint := fmt.Sprintf("/teammates/%s/subuser_access?limit=%d", url.PathEscape(username), teammatesPageSize)
+		if afterSubuserID != 0 {
+			endpoint += "&after_subuser_id=" + strconv.Itoa(int(afterSubuserID))
+		}
+
+		respBody, statusCode, err := c.Get(ctx, "GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        err,
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode: statusCode,
+				Err:        newAPIError(statusCode, respBody, nil),
+			}
+		}
+
+		var body TeammateSubuserAccesses
+		if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing teammate subuser access: %w", err),
+			}
+		}
+
+		result.HasRestrictedSubuserAccess = body.HasRestrictedSubuserAccess
+		result.SubuserAccess = append(result.SubuserAccess, body.SubuserAccess...)
+
+		if len(body.SubuserAccess) < teammatesPageSize {
+			return &result, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+
+		afterSubuserID = body.SubuserAccess[len(body.SubuserAccess)-1].ID
+	}
+}
+
+// UpdateTeammate edits the permissions of a teammate and returns it.
+func (c *Client) UpdateTeammate(
+	ctx context.Context,
+	username string,
+	isAdmin bool,
+	scopes []string,
+) (*Teammate, RequestError) {
+	if username == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTeammateUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/teammates/"+url.PathEscape(username),
+		newTeammatePermissions("", isAdmin, scopes))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedUpdatingTeammate),
+		}
+	}
+
+	return parseTeammate(respBody)
+}
+
+// UpdateSSOTeammate edits an SSO teammate, including its subuser access, and returns it.
+func (c *Client) UpdateSSOTeammate(ctx context.Context, username string, teammate *Teammate) (*Teammate, RequestError) {
+	if username == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTeammateUsernameRequired,
+		}
+	}
+
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/sso/teammates/"+url.PathEscape(username), teammate)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedUpdatingTeammate),
+		}
+	}
+
+	return parseTeammate(respBody)
+}
+
+// DeleteTeammate removes a teammate from the account.
+func (c *Client) DeleteTeammate(ctx context.Context, username string) (bool, RequestError) {
+	if username == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTeammateUsernameRequired,
+		}
+	}
+
+	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/teammates/"+url.PathEscape(username))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingTeammate),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// DeletePendingTeammate revokes an invitation which wasn't accepted yet.
+func (c *Client) DeletePendingTeammate(ctx context.Context, token string) (bool, RequestError) {
+	if token == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTeammateTokenRequired,
+		}
+	}
+
+	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/teammates/pending/"+url.PathEscape(token))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingTeammate),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
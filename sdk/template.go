@@ -64,7 +64,7 @@ func (c *Client) CreateTemplate(ctx context.Context, name, generation string) (*
 	if statusCode != http.StatusCreated {
 		return nil, &RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingTemplate, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingTemplate),
 		}
 	}
 
@@ -84,7 +84,7 @@ func (c *Client) ReadTemplate(ctx context.Context, id string) (*Template, error)
 	if statusCode != http.StatusOK {
 		return nil, &RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedGettingTemplate, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedGettingTemplate),
 		}
 	}
 
@@ -92,10 +92,16 @@ func (c *Client) ReadTemplate(ctx context.Context, id string) (*Template, error)
 }
 
 func (c *Client) ReadTemplates(ctx context.Context, generation string) ([]Template, error) {
-	respBody, _, err := c.Get(ctx, "GET", "/templates?page_size=200&generations="+generation)
+	respBody, statusCode, err := c.Get(ctx, "GET", "/templates?page_size=200&generations="+generation)
 	if err != nil {
 		return nil, fmt.Errorf("failed reading template: %w", err)
 	}
+	if statusCode != http.StatusOK {
+		return nil, &RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, ErrFailedGettingTemplate),
+		}
+	}
 
 	return parseTemplates(respBody)
 }
@@ -120,7 +126,7 @@ func (c *Client) UpdateTemplate(ctx context.Context, id, name string) (*Template
 	if statusCode != http.StatusOK {
 		return nil, &RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingTemplate, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedUpdatingTemplate),
 		}
 	}
 
@@ -135,7 +141,7 @@ func (c *Client) DeleteTemplate(ctx context.Context, id string) (bool, *RequestE
 		}
 	}
 
-	_, statusCode, err := c.Get(ctx, http.MethodDelete, "/templates/"+id)
+	respBody, statusCode, err := c.Get(ctx, http.MethodDelete, "/templates/"+id)
 	if err != nil {
 		return false, &RequestError{
 			StatusCode: statusCode,
@@ -145,7 +151,7 @@ func (c *Client) DeleteTemplate(ctx context.Context, id string) (bool, *RequestE
 	if statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
 		return false, &RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("failed deleting template: %d", statusCode),
+			Err:        newAPIError(statusCode, respBody, nil),
 		}
 	}
 	return true, nil
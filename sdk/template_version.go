@@ -56,8 +56,11 @@ func (c *Client) CreateTemplateVersion(ctx context.Context, t TemplateVersion) (
 		return nil, fmt.Errorf("failed creating template version: %w", err)
 	}
 
-	if statusCode >= 500 {
-		return nil, fmt.Errorf("failed creating the template version. Status: %d, Body: %s", statusCode, respBody)
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, &RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
 	}
 
 	return parseTemplateVersion(respBody)
@@ -73,11 +76,18 @@ func (c *Client) ReadTemplateVersion(ctx context.Context, templateID, id string)
 		return nil, ErrTemplateVersionIDRequired
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/templates/"+templateID+"/versions/"+id)
+	respBody, statusCode, err := c.Get(ctx, "GET", "/templates/"+templateID+"/versions/"+id)
 	if err != nil {
 		return nil, fmt.Errorf("failed reading template version: %w", err)
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, &RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseTemplateVersion(respBody)
 }
 
@@ -91,11 +101,18 @@ func (c *Client) UpdateTemplateVersion(ctx context.Context, t TemplateVersion) (
 		return nil, ErrTemplateIDRequired
 	}
 
-	respBody, _, err := c.Post(ctx, "PATCH", "/templates/"+t.TemplateID+"/versions/"+t.ID, t)
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/templates/"+t.TemplateID+"/versions/"+t.ID, t)
 	if err != nil {
 		return nil, fmt.Errorf("failed updating template version: %w", err)
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, &RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseTemplateVersion(respBody)
 }
 
@@ -105,14 +122,14 @@ func (c *Client) DeleteTemplateVersion(ctx context.Context, templateID, id strin
 		return false, ErrTemplateIDRequired
 	}
 
-	_, statusCode, err := c.Get(ctx, "DELETE", "/templates/"+templateID+"/versions/"+id)
+	respBody, statusCode, err := c.Get(ctx, "DELETE", "/templates/"+templateID+"/versions/"+id)
 	if err != nil {
 		return false, fmt.Errorf("failed deleting template version: %w", err)
 	}
 	if statusCode != http.StatusNoContent && statusCode != http.StatusNotFound {
 		return false, &RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("failed deleting template version: %d", statusCode),
+			Err:        newAPIError(statusCode, respBody, nil),
 		}
 	}
 
@@ -0,0 +1,65 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+)
+
+// TrackingSettingClick is the click tracking setting, rewriting the links of the emails
+// to track the clicks, through the link branding of the account if any.
+type TrackingSettingClick struct {
+	Enabled    bool `json:"enabled"`
+	EnableText bool `json:"enable_text"` //nolint:tagliatelle
+}
+
+// TrackingSettingOpen is the open tracking setting, adding an invisible image to the emails to track their opening.
+type TrackingSettingOpen struct {
+	Enabled bool `json:"enabled"`
+}
+
+// TrackingSettingSubscription is the subscription tracking setting, adding unsubscribe content to the emails.
+type TrackingSettingSubscription struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`  //nolint:tagliatelle
+	PlainContent string `json:"plain_content"` //nolint:tagliatelle
+	Landing      string `json:"landing"`
+	Replace      string `json:"replace"`
+	URL          string `json:"url"`
+}
+
+// TrackingSettingGoogleAnalytics is the Google Analytics tracking setting, adding UTM parameters
+// to the links of the emails.
+type TrackingSettingGoogleAnalytics struct {
+	Enabled     bool   `json:"enabled"`
+	UTMSource   string `json:"utm_source"`   //nolint:tagliatelle
+	UTMMedium   string `json:"utm_medium"`   //nolint:tagliatelle
+	UTMTerm     string `json:"utm_term"`     //nolint:tagliatelle
+	UTMContent  string `json:"utm_content"`  //nolint:tagliatelle
+	UTMCampaign string `json:"utm_campaign"` //nolint:tagliatelle
+}
+
+// ReadTrackingSetting retrieves the tracking setting name, like click, into setting,
+// a pointer to the TrackingSetting struct matching name.
+func (c *Client) ReadTrackingSetting(ctx context.Context, name string, setting interface{}) RequestError {
+	if name == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTrackingSettingNameRequired,
+		}
+	}
+
+	return c.readSetting(ctx, "tracking_settings", name, setting)
+}
+
+// UpdateTrackingSetting edits the tracking setting name, like click, and reads it back into setting,
+// a pointer to the TrackingSetting struct matching name.
+func (c *Client) UpdateTrackingSetting(ctx context.Context, name string, setting interface{}) RequestError {
+	if name == "" {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrTrackingSettingNameRequired,
+		}
+	}
+
+	return c.updateSetting(ctx, "tracking_settings", name, setting, ErrFailedUpdatingTrackingSetting)
+}
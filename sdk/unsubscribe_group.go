@@ -69,7 +69,7 @@ func (c *Client) CreateUnsubscribeGroup(
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingUnsubscribeGroup, statusCode, respBody),
+			Err:        newAPIError(statusCode, respBody, ErrFailedCreatingUnsubscribeGroup),
 		}
 	}
 
@@ -85,7 +85,7 @@ func (c *Client) ReadUnsubscribeGroup(ctx context.Context, id string) (*Unsubscr
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/asm/groups/"+id)
+	respBody, statusCode, err := c.Get(ctx, "GET", "/asm/groups/"+id)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -93,12 +93,19 @@ func (c *Client) ReadUnsubscribeGroup(ctx context.Context, id string) (*Unsubscr
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseUnsubscribeGroup(respBody)
 }
 
 // ReadUnsubscribeGroups retrieves all UnsubscribeGroup and returns them.
 func (c *Client) ReadUnsubscribeGroups(ctx context.Context) ([]UnsubscribeGroup, RequestError) {
-	respBody, _, err := c.Get(ctx, "GET", "/asm/groups")
+	respBody, statusCode, err := c.Get(ctx, "GET", "/asm/groups")
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -106,6 +113,13 @@ func (c *Client) ReadUnsubscribeGroups(ctx context.Context) ([]UnsubscribeGroup,
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseUnsubscribeGroups(respBody)
 }
 
@@ -132,7 +146,7 @@ func (c *Client) UpdateUnsubscribeGroup(
 		t.Description = description
 	}
 
-	respBody, _, err := c.Post(ctx, "PATCH", "/asm/groups/"+id, t)
+	respBody, statusCode, err := c.Post(ctx, "PATCH", "/asm/groups/"+id, t)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -140,6 +154,13 @@ func (c *Client) UpdateUnsubscribeGroup(
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        newAPIError(statusCode, respBody, nil),
+		}
+	}
+
 	return parseUnsubscribeGroup(respBody)
 }
 
@@ -163,7 +184,7 @@ func (c *Client) DeleteUnsubscribeGroup(ctx context.Context, id string) (bool, R
 	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
 		return false, RequestError{
 			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingUnsubscribeGroup, statusCode, responseBody),
+			Err:        newAPIError(statusCode, responseBody, ErrFailedDeletingUnsubscribeGroup),
 		}
 	}
 
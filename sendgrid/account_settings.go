@@ -0,0 +1,202 @@
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+// settingAPI reads and updates a family of settings of the account, like the mail settings.
+type settingAPI struct {
+	read   func(c *sendgrid.Client, ctx context.Context, name string, setting interface{}) sendgrid.RequestError
+	update func(c *sendgrid.Client, ctx context.Context, name string, setting interface{}) sendgrid.RequestError
+}
+
+var (
+	mailSettingsAPI = settingAPI{
+		read:   (*sendgrid.Client).ReadMailSetting,
+		update: (*sendgrid.Client).UpdateMailSetting,
+	}
+
+	trackingSettingsAPI = settingAPI{
+		read:   (*sendgrid.Client).ReadTrackingSetting,
+		update: (*sendgrid.Client).UpdateTrackingSetting,
+	}
+)
+
+// accountSetting describes a setting of the account, managed by a singleton resource
+// whose ID is the name of the setting, or the subuser it is managed on behalf of.
+type accountSetting struct {
+	// api is the family of the setting.
+	api settingAPI
+	// name is the name of the setting in the Sendgrid API, e.g. footer.
+	name string
+	// schema holds the attributes of the setting, besides enabled and on_behalf_of.
+	schema map[string]*schema.Schema
+	// new returns a pointer to an empty setting, to read the setting into.
+	new func() interface{}
+	// expand returns the setting configured in d, disabled when enabled is false.
+	expand func(d *schema.ResourceData, enabled bool) interface{}
+	// flatten sets the attributes of the setting in d.
+	flatten func(d *schema.ResourceData, setting interface{})
+	// defaults returns a pointer to the setting of a new account, restored when the resource is deleted.
+	// It is a new value on each call, since the response of the update is read into it.
+	defaults func() interface{}
+}
+
+func resourceSendgridAccountSetting(setting accountSetting) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"enabled": {
+			Type:        schema.TypeBool,
+			Description: "Indicates if the setting is enabled.",
+			Required:    true,
+		},
+		"on_behalf_of": onBehalfOfSchema(),
+	}
+
+	for k, v := range setting.schema {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceSendgridAccountSettingCreate(ctx, d, m, setting)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceSendgridAccountSettingRead(ctx, d, m, setting)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceSendgridAccountSettingUpdate(ctx, d, m, setting)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceSendgridAccountSettingDelete(ctx, d, m, setting)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+				if d.Id() != setting.name {
+					//nolint:errcheck
+					d.Set("on_behalf_of", d.Id())
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceSendgridAccountSettingCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	setting accountSetting,
+) diag.Diagnostics {
+	if onBehalfOf := d.Get("on_behalf_of").(string); onBehalfOf != "" {
+		d.SetId(onBehalfOf)
+	} else {
+		d.SetId(setting.name)
+	}
+
+	return resourceSendgridAccountSettingUpdate(ctx, d, m, setting)
+}
+
+func resourceSendgridAccountSettingRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	setting accountSetting,
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	s := setting.new()
+	if err := setting.api.read(c, ctx, setting.name, s); err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	setting.flatten(d, s)
+
+	return nil
+}
+
+func resourceSendgridAccountSettingUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	setting accountSetting,
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	s := setting.expand(d, d.Get("enabled").(bool))
+	if err := setting.api.update(c, withOnBehalfOf(ctx, d), setting.name, s); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return resourceSendgridAccountSettingRead(ctx, d, m, setting)
+}
+
+func resourceSendgridAccountSettingDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	setting accountSetting,
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	if err := setting.api.update(c, ctx, setting.name, setting.defaults()); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
+
+// mailSettingEnabled describes a mail setting which can only be enabled or disabled.
+func mailSettingEnabled(name string) accountSetting {
+	return accountSetting{
+		api:  mailSettingsAPI,
+		name: name,
+		new:  func() interface{} { return &sendgrid.MailSettingEnabled{} },
+		expand: func(_ *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.MailSettingEnabled{Enabled: enabled}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			//nolint:errcheck
+			d.Set("enabled", setting.(*sendgrid.MailSettingEnabled).Enabled)
+		},
+		defaults: func() interface{} { return &sendgrid.MailSettingEnabled{} },
+	}
+}
+
+// mailSettingForward describes the mail setting name forwarding emails to an address.
+func mailSettingForward(name, description string) accountSetting {
+	return accountSetting{
+		api:  mailSettingsAPI,
+		name: name,
+		schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Description: description,
+				Optional:    true,
+			},
+		},
+		new: func() interface{} { return &sendgrid.MailSettingForward{} },
+		expand: func(d *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.MailSettingForward{
+				Enabled: enabled,
+				Email:   d.Get("email").(string),
+			}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			forward := setting.(*sendgrid.MailSettingForward)
+			//nolint:errcheck
+			d.Set("enabled", forward.Enabled)
+			//nolint:errcheck
+			d.Set("email", forward.Email)
+		},
+		defaults: func() interface{} { return &sendgrid.MailSettingForward{} },
+	}
+}
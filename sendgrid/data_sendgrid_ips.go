@@ -0,0 +1,99 @@
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func dataSendgridIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSendgridIPsRead,
+		Schema: map[string]*schema.Schema{
+			"ips": {
+				Type:        schema.TypeList,
+				Description: "The IPs of the account.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "The IP address.",
+							Computed:    true,
+						},
+						"warmup": {
+							Type:        schema.TypeBool,
+							Description: "Indicates if the IP is being warmed up.",
+							Computed:    true,
+						},
+						"start_date": {
+							Type:        schema.TypeInt,
+							Description: "The date the warmup of the IP started, as a unix timestamp.",
+							Computed:    true,
+						},
+						"subusers": {
+							Type:        schema.TypeList,
+							Description: "The subusers the IP is assigned to.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"pools": {
+							Type:        schema.TypeList,
+							Description: "The IP pools the IP belongs to.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"rdns": {
+							Type:        schema.TypeString,
+							Description: "The reverse DNS record of the IP.",
+							Computed:    true,
+						},
+						"whitelabeled": {
+							Type:        schema.TypeBool,
+							Description: "Indicates if the IP has a reverse DNS record.",
+							Computed:    true,
+						},
+						"assigned_at": {
+							Type:        schema.TypeInt,
+							Description: "The date the IP was assigned to the account, as a unix timestamp.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSendgridIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	ips, err := c.ReadIPs(ctx)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(ips))
+	for _, ip := range ips {
+		result = append(result, map[string]interface{}{
+			"ip":           ip.IP,
+			"warmup":       ip.Warmup,
+			"start_date":   ip.StartDate,
+			"subusers":     ip.Subusers,
+			"pools":        ip.Pools,
+			"rdns":         ip.RDNS,
+			"whitelabeled": ip.Whitelabeled,
+			"assigned_at":  ip.AssignedAt,
+		})
+	}
+
+	d.SetId("ips")
+
+	if err := d.Set("ips", result); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func dataSendgridParseWebhooks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSendgridParseWebhooksRead,
+		Schema: map[string]*schema.Schema{
+			"on_behalf_of": dataOnBehalfOfSchema(),
+			"parse_webhooks": {
+				Type:        schema.TypeList,
+				Description: "The inbound parse settings of the account.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "The domain or subdomain whose incoming email is parsed.",
+							Computed:    true,
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Description: "The public URL the data parsed from the email is POSTed to.",
+							Computed:    true,
+						},
+						"spam_check": {
+							Type:        schema.TypeBool,
+							Description: "Indicates if the content parsed from the email is checked for spam.",
+							Computed:    true,
+						},
+						"send_raw": {
+							Type:        schema.TypeBool,
+							Description: "Indicates if the original MIME-type content of the email is posted.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"hostnames": {
+				Type:        schema.TypeList,
+				Description: "The hostnames of the inbound parse settings of the account.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSendgridParseWebhooksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	webhooks, err := c.ReadParseWebhooks(ctx)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(webhooks))
+	hostnames := make([]string, 0, len(webhooks))
+
+	for _, webhook := range webhooks {
+		result = append(result, map[string]interface{}{
+			"hostname":   webhook.Hostname,
+			"url":        webhook.URL,
+			"spam_check": webhook.SpamCheck,
+			"send_raw":   webhook.SendRaw,
+		})
+		hostnames = append(hostnames, webhook.Hostname)
+	}
+
+	d.SetId("parse_webhooks")
+
+	if err := d.Set("parse_webhooks", result); err != nil {
+		return diagFromErr(err)
+	}
+
+	if err := d.Set("hostnames", hostnames); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
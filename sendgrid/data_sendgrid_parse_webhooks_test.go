@@ -0,0 +1,84 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridParseWebhooksDataSource(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridParseWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridParseWebhooksConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					// The account may have other parse webhooks, only the ones of the test are checked.
+					resource.TestCheckTypeSetElemAttr("data.sendgrid_parse_webhooks.all", "hostnames.*", "a."+domain),
+					resource.TestCheckTypeSetElemAttr("data.sendgrid_parse_webhooks.all", "hostnames.*", "b."+domain),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sendgrid_parse_webhooks.all", "parse_webhooks.*",
+						map[string]string{
+							"hostname": "a." + domain,
+							"url":      "https://example.org/a",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sendgrid_parse_webhooks.all", "parse_webhooks.*",
+						map[string]string{
+							"hostname":   "b." + domain,
+							"url":        "https://example.org/b",
+							"spam_check": "true",
+							"send_raw":   "false",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridParseWebhookDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_parse_webhook" {
+			continue
+		}
+
+		_, err := c.ReadParseWebhook(context.Background(), rs.Primary.ID)
+		if err.Err == nil {
+			return fmt.Errorf("parse webhook %s still exists", rs.Primary.ID)
+		}
+
+		if !errors.Is(err.Err, sendgrid.ErrNotFound) {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridParseWebhooksConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_parse_webhook" "a" {
+  hostname = "a.%[1]s"
+  url      = "https://example.org/a"
+}
+
+resource "sendgrid_parse_webhook" "b" {
+  hostname   = "b.%[1]s"
+  url        = "https://example.org/b"
+  spam_check = true
+}
+
+data "sendgrid_parse_webhooks" "all" {
+  depends_on = [sendgrid_parse_webhook.a, sendgrid_parse_webhook.b]
+}`, domain)
+}
@@ -0,0 +1,160 @@
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+type suppressionsReadFunc func(
+	c *sendgrid.Client,
+	ctx context.Context,
+	filter sendgrid.SuppressionFilter,
+) ([]sendgrid.Suppression, sendgrid.RequestError)
+
+// suppressionFields describes the fields a suppression list may provide, besides the email and creation date.
+var suppressionFields = map[string]string{
+	"reason": "Why the recipient was suppressed, as reported by its email server.",
+	"status": "The enhanced SMTP status code returned by the email server of the recipient.",
+	"ip":     "The IP the reported email was sent from.",
+}
+
+func dataSendgridBounces() *schema.Resource {
+	return dataSendgridSuppressions("bounces", "The recipients whose emails bounced.",
+		(*sendgrid.Client).ReadBounces, "reason", "status")
+}
+
+func dataSendgridBlocks() *schema.Resource {
+	return dataSendgridSuppressions("blocks", "The recipients whose email server blocked emails.",
+		(*sendgrid.Client).ReadBlocks, "reason", "status")
+}
+
+func dataSendgridSpamReports() *schema.Resource {
+	return dataSendgridSuppressions("spam_reports", "The recipients who reported emails as spam.",
+		(*sendgrid.Client).ReadSpamReports, "ip")
+}
+
+func dataSendgridInvalidEmails() *schema.Resource {
+	return dataSendgridSuppressions("invalid_emails", "The recipients whose email is invalid.",
+		(*sendgrid.Client).ReadInvalidEmails, "reason")
+}
+
+// dataSendgridSuppressions returns a data source listing in attribute the suppressions read by read,
+// each with the given fields besides its email and creation date.
+func dataSendgridSuppressions(
+	attribute string,
+	description string,
+	read suppressionsReadFunc,
+	fields ...string,
+) *schema.Resource {
+	elem := map[string]*schema.Schema{
+		"email": {
+			Type:        schema.TypeString,
+			Description: "The email of the recipient.",
+			Computed:    true,
+		},
+		"created": {
+			Type:        schema.TypeInt,
+			Description: "When the recipient was suppressed, as a unix timestamp.",
+			Computed:    true,
+		},
+	}
+
+	for _, field := range fields {
+		elem[field] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: suppressionFields[field],
+			Computed:    true,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return dataSendgridSuppressionsRead(ctx, d, m, attribute, read, fields)
+		},
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:        schema.TypeInt,
+				Description: "Only list the recipients suppressed since then, as a unix timestamp.",
+				Optional:    true,
+			},
+			"end_time": {
+				Type:        schema.TypeInt,
+				Description: "Only list the recipients suppressed until then, as a unix timestamp.",
+				Optional:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "Only list the recipient with this email.",
+				Optional:    true,
+			},
+			"on_behalf_of": dataOnBehalfOfSchema(),
+			attribute: {
+				Type:        schema.TypeList,
+				Description: description,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: elem},
+			},
+		},
+	}
+}
+
+func dataSendgridSuppressionsRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	attribute string,
+	read suppressionsReadFunc,
+	fields []string,
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	suppressions, err := read(c, ctx, sendgrid.SuppressionFilter{
+		StartTime: int64(d.Get("start_time").(int)),
+		EndTime:   int64(d.Get("end_time").(int)),
+		Email:     d.Get("email").(string),
+	})
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(suppressions))
+
+	for _, suppression := range suppressions {
+		values := map[string]interface{}{
+			"email":   suppression.Email,
+			"created": suppression.Created,
+		}
+
+		for _, field := range fields {
+			values[field] = suppressionField(suppression, field)
+		}
+
+		result = append(result, values)
+	}
+
+	d.SetId(attribute)
+
+	if err := d.Set(attribute, result); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+// suppressionField returns the value of one of the suppressionFields of suppression.
+func suppressionField(suppression sendgrid.Suppression, field string) string {
+	switch field {
+	case "reason":
+		return suppression.Reason
+	case "status":
+		return suppression.Status
+	case "ip":
+		return suppression.IP
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,60 @@
+package sendgrid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The suppressions are the ones Sendgrid added to the account of the fake API, see sendgridtest.
+func TestAccSendgridSuppressionsDataSources(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("the suppressions are only known in the fake Sendgrid API")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sendgrid_bounces" "all" {}
+
+data "sendgrid_bounces" "since" {
+  start_time = 1580000000
+}
+
+data "sendgrid_blocks" "one" {
+  email = "blocked@example.org"
+}
+
+data "sendgrid_blocks" "none" {
+  email = "delivered@example.org"
+}
+
+data "sendgrid_spam_reports" "all" {}
+
+data "sendgrid_invalid_emails" "until" {
+  end_time = 1577836800
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.all", "bounces.#", "2"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.since", "bounces.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.since", "bounces.0.email", "full@example.org"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.since", "bounces.0.status", "5.2.2"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.since", "bounces.0.created", "1580515200"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_bounces.since", "bounces.0.reason"),
+					resource.TestCheckResourceAttr("data.sendgrid_blocks.one", "blocks.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_blocks.one", "blocks.0.status", "5.7.1"),
+					resource.TestCheckResourceAttr("data.sendgrid_blocks.none", "blocks.#", "0"),
+					resource.TestCheckResourceAttr("data.sendgrid_spam_reports.all", "spam_reports.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_spam_reports.all", "spam_reports.0.ip", "127.0.0.1"),
+					resource.TestCheckNoResourceAttr("data.sendgrid_spam_reports.all", "spam_reports.0.reason"),
+					resource.TestCheckResourceAttr("data.sendgrid_invalid_emails.until", "invalid_emails.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.sendgrid_invalid_emails.until", "invalid_emails.0.email", "invalid@example"),
+				),
+			},
+		},
+	})
+}
@@ -20,6 +20,7 @@ func dataSendgridTemplate() *schema.Resource {
 		val.ForceNew = true
 	}
 
+	s["on_behalf_of"] = dataOnBehalfOfSchema()
 	s["template_id"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -47,12 +48,17 @@ func dataSendgridTemplateRead(context context.Context, d *schema.ResourceData, m
 	templateID := d.Get("template_id").(string)
 	name := d.Get("name").(string)
 	c := m.(*sendgrid.Client)
+	context = withOnBehalfOf(context, d)
 
 	switch {
 	case templateID != "":
 		d.SetId(templateID)
 
-		return resourceSendgridTemplateRead(context, d, m)
+		if diags := resourceSendgridTemplateRead(context, d, m); diags.HasError() || d.Id() != "" {
+			return diags
+		}
+
+		return diag.Errorf("unable to find a template with ID '%s'", templateID)
 	case name != "":
 		generation := d.Get("generation").(string)
 		if generation == "" {
@@ -61,7 +67,7 @@ func dataSendgridTemplateRead(context context.Context, d *schema.ResourceData, m
 
 		templates, err := c.ReadTemplates(context, generation)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		names := make([]string, 0)
@@ -73,7 +79,7 @@ func dataSendgridTemplateRead(context context.Context, d *schema.ResourceData, m
 				d.SetId(template.ID)
 
 				if err = sendgridTemplateParse(&template, d); err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 
 				return nil
@@ -21,6 +21,8 @@ func dataSendgridTemplateVersion() *schema.Resource {
 		}
 	}
 
+	s["on_behalf_of"] = dataOnBehalfOfSchema()
+
 	return &schema.Resource{
 		ReadContext: dataSendgridTemplateVersionRead,
 		Schema:      s,
@@ -30,10 +32,11 @@ func dataSendgridTemplateVersion() *schema.Resource {
 func dataSendgridTemplateVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	templateID := d.Get("template_id").(string)
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	template, err := c.ReadTemplate(ctx, templateID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var activeVersion *sendgrid.TemplateVersion
@@ -53,7 +56,7 @@ func dataSendgridTemplateVersionRead(ctx context.Context, d *schema.ResourceData
 	d.SetId(activeVersion.ID)
 
 	if err := parseTemplateVersion(d, activeVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
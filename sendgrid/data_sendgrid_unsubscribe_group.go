@@ -21,6 +21,7 @@ func dataSendgridUnsubscribeGroup() *schema.Resource {
 		val.ForceNew = true
 	}
 
+	s["on_behalf_of"] = dataOnBehalfOfSchema()
 	s["group_id"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -42,16 +43,21 @@ func dataSendgridUnsubscribeGroupRead(context context.Context, d *schema.Resourc
 	groupID := d.Get("group_id").(string)
 	name := d.Get("name").(string)
 	c := m.(*sendgrid.Client)
+	context = withOnBehalfOf(context, d)
 
 	switch {
 	case groupID != "":
 		d.SetId(groupID)
 
-		return resourceSendgridUnsubscribeGroupRead(context, d, m)
+		if diags := resourceSendgridUnsubscribeGroupRead(context, d, m); diags.HasError() || d.Id() != "" {
+			return diags
+		}
+
+		return diag.Errorf("unable to find a unsubscribe group with ID '%s'", groupID)
 	case name != "":
 		groups, err := c.ReadUnsubscribeGroups(context)
 		if err.Err != nil {
-			return diag.FromErr(err.Err)
+			return diagFromErr(err.Err)
 		}
 
 		for i := range groups {
@@ -60,7 +66,7 @@ func dataSendgridUnsubscribeGroupRead(context context.Context, d *schema.Resourc
 				d.SetId(fmt.Sprint(group.ID))
 
 				if err := sendgridUnsubscribeGroupParse(&group, d); err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 
 				return nil
@@ -0,0 +1,62 @@
+package sendgrid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dnsRecordResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"valid": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if this is a valid CNAME.",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of DNS record.",
+				Computed:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The domain that this CNAME is created for.",
+				Computed:    true,
+			},
+			"data": {
+				Type:        schema.TypeString,
+				Description: "The actual DNS record.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// dnsRecordsSchema holds the DNS records keyed by role and field, so that each can be referenced by its role,
+// e.g. `dns_records["dkim1.host"]`. Nested maps can't be stored in the state, hence the `<role>.<field>` keys;
+// a role unused by Sendgrid has no keys.
+func dnsRecordsSchema(roles ...string) *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeMap,
+		Description: fmt.Sprintf("The DNS records keyed by `<role>.<field>`, e.g. `dns_records[\"%s.host\"]`. ", roles[0]) +
+			fmt.Sprintf("The roles are `%s`; the ones unused by Sendgrid are absent. ", strings.Join(roles, "`, `")) +
+			"The fields are `valid` (`true` or `false`), `type`, `host` and `data`.",
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// setDNSRecord adds the fields of the record of role to the records set in a dnsRecordsSchema, unless there is none.
+func setDNSRecord(records map[string]interface{}, role string, valid bool, recordType, host, data string) {
+	if recordType == "" {
+		return
+	}
+
+	records[role+".valid"] = strconv.FormatBool(valid)
+	records[role+".type"] = recordType
+	records[role+".host"] = host
+	records[role+".data"] = data
+}
@@ -2,7 +2,13 @@ package sendgrid
 
 import (
 	"errors"
-	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
 )
 
 var (
@@ -13,8 +19,41 @@ var (
 	// doesn't have the good format.
 	ErrInvalidImportFormat = errors.New("invalid import. Supported import format: {{templateID}}/{{templateVersionID}}")
 
-	// ErrSubUserNotFound error displayed when the subUser can not be found.
-	ErrSubUserNotFound = errors.New("subUser wasn't found")
+	// ErrInvalidIPPoolMembershipImportFormat error displayed when the string passed to import an IP pool membership
+	// doesn't have the good format.
+	ErrInvalidIPPoolMembershipImportFormat = errors.New("invalid import. Supported import format: {{poolName}}/{{ip}}")
+
+	// ErrInvalidDomainAuthenticationSubuserImportFormat error displayed when the string passed to import
+	// a domain authentication subuser association doesn't have the good format.
+	ErrInvalidDomainAuthenticationSubuserImportFormat = errors.New(
+		"invalid import. Supported import format: {{domainID}}/{{username}}")
+
+	// ErrInvalidLinkBrandingSubuserImportFormat error displayed when the string passed to import
+	// a link branding subuser association doesn't have the good format.
+	ErrInvalidLinkBrandingSubuserImportFormat = errors.New("invalid import. Supported import format: {{linkID}}/{{username}}")
+
+	// ErrLegacyEventWebhookNotFound error displayed when the event webhook of a state predating multiple
+	// event webhooks can't be found anymore.
+	ErrLegacyEventWebhookNotFound = errors.New("no event webhook to migrate the state of")
+
+	// ErrEventWebhookNotFound error displayed when the account to import the event webhook of has none.
+	ErrEventWebhookNotFound = errors.New("no event webhook found for account")
+
+	// ErrInvalidEventWebhookImportFormat error displayed when the string passed to import an event webhook
+	// doesn't have the good format.
+	ErrInvalidEventWebhookImportFormat = errors.New(
+		"invalid import. Supported import formats: {{id}}, default, {{username}} or {{username}}/{{id}}")
+
+	// ErrInvalidUnsubscribeGroupSuppressionsImportFormat error displayed when the string passed to import
+	// the suppressions of an unsubscribe group doesn't have the good format.
+	ErrInvalidUnsubscribeGroupSuppressionsImportFormat = errors.New(
+		"invalid import. Supported import formats: {{groupID}} or {{username}}/{{groupID}}")
+
+	// ErrSubuserCreditsTotalRequired error displayed when credits other than unlimited have no total.
+	ErrSubuserCreditsTotalRequired = errors.New("total is required unless the type of the credits is unlimited")
+
+	// ErrSubuserCreditsResetFrequencyRequired error displayed when recurring credits have no reset frequency.
+	ErrSubuserCreditsResetFrequencyRequired = errors.New("reset_frequency is required for recurring credits")
 
 	// ErrNoNewVersionFoundForTemplate error displayed when no recent version can be found for a given template.
 	ErrNoNewVersionFoundForTemplate = errors.New("no recent version found for template_id")
@@ -76,6 +115,57 @@ var (
 	ErrSetUnsubscribeGroupUnsuscribes = errors.New("could not set unsubscribe group unsubscribes attribute")
 )
 
-func subUserNotFound(name string) error {
-	return fmt.Errorf("%w: %s", ErrSubUserNotFound, name)
+// apiFieldAttributes maps the fields named by the Sendgrid API in its errors to the attribute
+// they are configured with, when the names differ.
+var apiFieldAttributes = map[string]string{
+	"default": "is_default",
+}
+
+var attributeName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// diagFromErr converts err to diagnostics like diag.FromErr does, except that each error the
+// Sendgrid API reports about a field is attached to the matching attribute, so that Terraform
+// points at the offending argument.
+func diagFromErr(err error) diag.Diagnostics {
+	var apiErr *sendgrid.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) == 0 {
+		return diag.FromErr(err)
+	}
+
+	diags := make(diag.Diagnostics, 0, len(apiErr.Errors))
+
+	for _, e := range apiErr.Errors {
+		d := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  e.Message,
+			Detail:   err.Error(),
+		}
+
+		attribute := e.Field
+		if a, ok := apiFieldAttributes[attribute]; ok {
+			attribute = a
+		}
+
+		if attributeName.MatchString(attribute) {
+			d.AttributePath = cty.GetAttrPath(attribute)
+		}
+
+		diags = append(diags, d)
+	}
+
+	return diags
+}
+
+// diagFromReadErr converts an error reading the object backing d to diagnostics.
+// An object deleted outside of Terraform isn't an error: it is removed from the state instead,
+// so that Terraform plans to recreate it.
+func diagFromReadErr(d *schema.ResourceData, err error) diag.Diagnostics {
+	if errors.Is(err, sendgrid.ErrNotFound) && !d.IsNewResource() {
+		log.Printf("[WARN] %s not found, removing it from the state", d.Id())
+		d.SetId("")
+
+		return nil
+	}
+
+	return diagFromErr(err)
 }
@@ -0,0 +1,32 @@
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func onBehalfOfSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeString,
+		Description: "The username of the subuser to manage this object on behalf of. " +
+			"Defaults to the `subuser` configured on the provider.",
+		Optional: true,
+		ForceNew: true,
+	}
+}
+
+func dataOnBehalfOfSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeString,
+		Description: "The username of the subuser to read this object on behalf of. " +
+			"Defaults to the `subuser` configured on the provider.",
+		Optional: true,
+	}
+}
+
+// withOnBehalfOf scopes the requests sent with ctx to the on_behalf_of subuser of d, if any.
+func withOnBehalfOf(ctx context.Context, d *schema.ResourceData) context.Context {
+	return sendgrid.WithOnBehalfOf(ctx, d.Get("on_behalf_of").(string))
+}
@@ -4,23 +4,64 @@ Resources List
 API key Resource
   sendgrid_api_key
 
-Domain authentication Resource
+Domain authentication Resources
   sendgrid_domain_authentication
+  sendgrid_domain_authentication_subuser
 
-Link branding Resource
+IP Resources
+  sendgrid_ip_pool
+  sendgrid_ip_pool_membership
+  sendgrid_ip_warmup
+
+Link branding Resources
   sendgrid_link_branding
+  sendgrid_link_branding_subuser
+
+Mail Settings Resources
+  sendgrid_mail_settings_address_whitelist
+  sendgrid_mail_settings_bounce_purge
+  sendgrid_mail_settings_bypass_bounce_management
+  sendgrid_mail_settings_bypass_list_management
+  sendgrid_mail_settings_bypass_spam_management
+  sendgrid_mail_settings_bypass_unsubscribe_management
+  sendgrid_mail_settings_footer
+  sendgrid_mail_settings_forward_bounce
+  sendgrid_mail_settings_forward_spam
+  sendgrid_mail_settings_plain_content
+  sendgrid_mail_settings_spam_check
+
+Reverse DNS Resource
+  sendgrid_reverse_dns
+
+Sender Identity Resource
+  sendgrid_sender_identity
 
 SSO Resources
   sendgrid_sso_certificate
   sendgrid sso_integration
 
-Subuser Resource
+Subuser Resources
   sendgrid_subuser
+  sendgrid_subuser_credits
+  sendgrid_subuser_monitor
+
+Teammate Resource
+  sendgrid_teammate
 
 Template Resources
   sendgrid_template
   sendgrid_template_version
 
+Suppression Resources
+  sendgrid_global_suppression
+  sendgrid_unsubscribe_group_suppressions
+
+Tracking Settings Resources
+  sendgrid_tracking_settings_click
+  sendgrid_tracking_settings_google_analytics
+  sendgrid_tracking_settings_open
+  sendgrid_tracking_settings_subscription
+
 Unsubscribe Group Resource
   sendgrid_unsubscribe_group
 
@@ -32,15 +73,19 @@ package sendgrid
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
 )
 
 const (
 	maxStringLength        = 100
 	unsubscribeGroupLength = 30
+	ipPoolNameLength       = 64
 )
 
 // Provider terraform.ResourceProvider.
@@ -63,26 +108,75 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SENDGRID_SUBUSER", nil),
 			},
+			"max_retries": {
+				Type: schema.TypeInt,
+				Description: "The number of times a request is retried when it is rate limited, " +
+					"or fails with a transient server error if it doesn't create an object.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SENDGRID_MAX_RETRIES", sendgrid.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_backoff": {
+				Type: schema.TypeString,
+				Description: "The maximum delay between two retries, as a duration (e.g. `30s`). " +
+					"The reset time of a rate limited request is always honored.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SENDGRID_MAX_BACKOFF", sendgrid.DefaultMaxBackoff.String()),
+				ValidateFunc: validateDuration,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"sendgrid_template":          dataSendgridTemplate(),
 			"sendgrid_template_version":  dataSendgridTemplateVersion(),
 			"sendgrid_unsubscribe_group": dataSendgridUnsubscribeGroup(),
+			"sendgrid_ips":               dataSendgridIPs(),
+			"sendgrid_parse_webhooks":    dataSendgridParseWebhooks(),
+			"sendgrid_bounces":           dataSendgridBounces(),
+			"sendgrid_blocks":            dataSendgridBlocks(),
+			"sendgrid_spam_reports":      dataSendgridSpamReports(),
+			"sendgrid_invalid_emails":    dataSendgridInvalidEmails(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"sendgrid_api_key":               resourceSendgridAPIKey(),
-			"sendgrid_subuser":               resourceSendgridSubuser(),
-			"sendgrid_template":              resourceSendgridTemplate(),
-			"sendgrid_template_version":      resourceSendgridTemplateVersion(),
-			"sendgrid_unsubscribe_group":     resourceSendgridUnsubscribeGroup(),
-			"sendgrid_parse_webhook":         resourceSendgridParseWebhook(),
-			"sendgrid_event_webhook":         resourceSendgridEventWebhook(),
-			"sendgrid_domain_authentication": resourceSendgridDomainAuthentication(),
-			"sendgrid_link_branding":         resourceSendgridLinkBranding(),
-			"sendgrid_sso_integration":       resourceSendgridSSOIntegration(),
-			"sendgrid_sso_certificate":       resourceSendgridSSOCertificate(),
+			"sendgrid_api_key":                                     resourceSendgridAPIKey(),
+			"sendgrid_subuser":                                     resourceSendgridSubuser(),
+			"sendgrid_subuser_credits":                             resourceSendgridSubuserCredits(),
+			"sendgrid_subuser_monitor":                             resourceSendgridSubuserMonitor(),
+			"sendgrid_template":                                    resourceSendgridTemplate(),
+			"sendgrid_template_version":                            resourceSendgridTemplateVersion(),
+			"sendgrid_unsubscribe_group":                           resourceSendgridUnsubscribeGroup(),
+			"sendgrid_parse_webhook":                               resourceSendgridParseWebhook(),
+			"sendgrid_event_webhook":                               resourceSendgridEventWebhook(),
+			"sendgrid_domain_authentication":                       resourceSendgridDomainAuthentication(),
+			"sendgrid_link_branding":                               resourceSendgridLinkBranding(),
+			"sendgrid_sso_integration":                             resourceSendgridSSOIntegration(),
+			"sendgrid_sso_certificate":                             resourceSendgridSSOCertificate(),
+			"sendgrid_sender_identity":                             resourceSendgridSenderIdentity(),
+			"sendgrid_teammate":                                    resourceSendgridTeammate(),
+			"sendgrid_ip_pool":                                     resourceSendgridIPPool(),
+			"sendgrid_ip_pool_membership":                          resourceSendgridIPPoolMembership(),
+			"sendgrid_ip_warmup":                                   resourceSendgridIPWarmup(),
+			"sendgrid_reverse_dns":                                 resourceSendgridReverseDNS(),
+			"sendgrid_domain_authentication_subuser":               resourceSendgridDomainAuthenticationSubuser(),
+			"sendgrid_link_branding_subuser":                       resourceSendgridLinkBrandingSubuser(),
+			"sendgrid_global_suppression":                          resourceSendgridGlobalSuppression(),
+			"sendgrid_unsubscribe_group_suppressions":              resourceSendgridUnsubscribeGroupSuppressions(),
+			"sendgrid_mail_settings_footer":                        resourceSendgridMailSettingsFooter(),
+			"sendgrid_mail_settings_bypass_list_management":        resourceSendgridMailSettingsBypassListManagement(),
+			"sendgrid_mail_settings_bypass_spam_management":        resourceSendgridMailSettingsBypassSpamManagement(),
+			"sendgrid_mail_settings_bypass_bounce_management":      resourceSendgridMailSettingsBypassBounceManagement(),
+			"sendgrid_mail_settings_bypass_unsubscribe_management": resourceSendgridMailSettingsBypassUnsubscribeManagement(),
+			"sendgrid_mail_settings_forward_bounce":                resourceSendgridMailSettingsForwardBounce(),
+			"sendgrid_mail_settings_forward_spam":                  resourceSendgridMailSettingsForwardSpam(),
+			"sendgrid_mail_settings_bounce_purge":                  resourceSendgridMailSettingsBouncePurge(),
+			"sendgrid_mail_settings_address_whitelist":             resourceSendgridMailSettingsAddressWhitelist(),
+			"sendgrid_mail_settings_spam_check":                    resourceSendgridMailSettingsSpamCheck(),
+			"sendgrid_mail_settings_plain_content":                 resourceSendgridMailSettingsPlainContent(),
+			"sendgrid_tracking_settings_click":                     resourceSendgridTrackingSettingsClick(),
+			"sendgrid_tracking_settings_open":                      resourceSendgridTrackingSettingsOpen(),
+			"sendgrid_tracking_settings_subscription":              resourceSendgridTrackingSettingsSubscription(),
+			"sendgrid_tracking_settings_google_analytics":          resourceSendgridTrackingSettingsGoogleAnalytics(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -108,5 +202,27 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 	host := d.Get("host").(string)
 	subuser := d.Get("subuser").(string)
 
-	return sendgrid.NewClient(apiKey, host, subuser), diags
+	maxBackoff, err := time.ParseDuration(d.Get("max_backoff").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	c := sendgrid.NewClient(apiKey, host, subuser)
+	c.MaxRetries = d.Get("max_retries").(int)
+	c.MaxBackoff = maxBackoff
+
+	return c, diags
+}
+
+func validateDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)} //nolint:goerr113
+	}
+
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+
+	return nil, nil
 }
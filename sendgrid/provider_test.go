@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/taharah/terraform-provider-sendgrid/sdk/sendgridtest"
 	"github.com/taharah/terraform-provider-sendgrid/sendgrid"
 )
 
@@ -13,6 +14,9 @@ var testAccProviders map[string]*schema.Provider
 
 var testAccProvider *schema.Provider
 
+// testAccServer is the fake Sendgrid API the acceptance tests run against, nil against a live account.
+var testAccServer *sendgridtest.Server
+
 func init() {
 	testAccProvider = sendgrid.Provider()
 	testAccProviders = map[string]*schema.Provider{
@@ -20,6 +24,24 @@ func init() {
 	}
 }
 
+// TestMain runs the acceptance tests against an in-memory fake of the Sendgrid API
+// when no SENDGRID_API_KEY is set, so that they can run without a live account.
+func TestMain(m *testing.M) {
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
+	if os.Getenv("SENDGRID_API_KEY") == "" {
+		testAccServer = sendgridtest.NewServer()
+		defer testAccServer.Close()
+
+		os.Setenv("SENDGRID_API_KEY", sendgridtest.APIKey)
+		os.Setenv("SENDGRID_HOST", testAccServer.Host())
+	}
+
+	return m.Run()
+}
+
 func TestProvider(t *testing.T) {
 	if err := sendgrid.Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -65,12 +65,14 @@ func resourceSendgridAPIKey() *schema.Resource {
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"on_behalf_of": onBehalfOfSchema(),
 		},
 	}
 }
 
 func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 	req := &sendgrid.APIKey{}
 
 	if v, ok := d.GetOk("name"); ok {
@@ -91,15 +93,12 @@ func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	log.Printf("[DEBUG] creating API Key: %s", req.Name)
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateAPIKey(ctx, req)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	apiKey, err := c.CreateAPIKey(ctx, req)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 	log.Printf("[DEBUG] created API Key: %s", req.Name)
 
-	apiKey := apiKeyStruct.(*sendgrid.APIKey)
 	d.SetId(apiKey.ID)
 	d.Set("api_key", apiKey.APIKey)
 
@@ -108,10 +107,11 @@ func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m
 
 func resourceSendgridAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	apiKey, err := c.ReadAPIKey(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return diagFromReadErr(d, err.Err)
 	}
 
 	d.Set("name", apiKey.Name)
@@ -121,6 +121,7 @@ func resourceSendgridAPIKeyRead(ctx context.Context, d *schema.ResourceData, m i
 
 func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 	req := &sendgrid.APIKey{}
 
 	if d.HasChange("name") {
@@ -141,11 +142,9 @@ func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	log.Printf("[DEBUG] updating API Key: %s", req.Name)
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateAPIKey(ctx, d.Id(), req)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.UpdateAPIKey(ctx, d.Id(), req)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 	log.Printf("[DEBUG] updated API Key: %s", req.Name)
 
@@ -154,9 +153,10 @@ func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m
 
 func resourceSendgridAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	if _, err := c.DeleteAPIKey(ctx, d.Id()); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
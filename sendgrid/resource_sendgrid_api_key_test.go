@@ -89,6 +89,39 @@ func TestAccSendgridAPIKeyUpdateName(t *testing.T) {
 	})
 }
 
+func TestAccSendgridAPIKeyDisappears(t *testing.T) {
+	name := "terraform-api-key-" + acctest.RandString(10)
+	scopes := []string{"mail.send"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridAPIKeyConfigBasic(name, scopes),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridAPIKeyExists("sendgrid_api_key.this", name),
+					testAccCheckSendgridAPIKeyDisappears("sendgrid_api_key.this"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridAPIKeyDisappears(resource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		if _, err := c.DeleteAPIKey(context.Background(), s.RootModule().Resources[resource].Primary.ID); err != nil {
+			return err.Err
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckSendgridAPIKeyDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*sendgrid.Client)
 
@@ -1,5 +1,7 @@
 /*
 Provide a resource to manage a domain authentication.
+When `wait_for_validation` times out, the domain authentication is kept and a warning reports the invalid DNS records.
+Waiting for more than 20 minutes requires raising the `create` and `update` timeouts too.
 Example Usage
 ```hcl
 
@@ -8,6 +10,11 @@ Example Usage
 	    ips = [ "10.10.10.10" ]
 	    is_default = true
 	    automatic_security = false
+
+	    wait_for_validation {
+	        timeout       = "15m"
+	        poll_interval = "1m"
+	    }
 	}
 
 ```
@@ -34,6 +41,7 @@ func resourceSendgridDomainAuthentication() *schema.Resource { //nolint:funlen
 		ReadContext:   resourceSendgridDomainAuthenticationRead,
 		UpdateContext: resourceSendgridDomainAuthenticationUpdate,
 		DeleteContext: resourceSendgridDomainAuthenticationDelete,
+		Timeouts:      waitForValidationTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -99,40 +107,33 @@ func resourceSendgridDomainAuthentication() *schema.Resource { //nolint:funlen
 				Type:        schema.TypeList,
 				Description: "The DNS records used to authenticate the sending domain.",
 				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"valid": {
-							Type:        schema.TypeBool,
-							Description: "Indicates if this is a valid CNAME.",
-							Computed:    true,
-						},
-						"type": {
-							Type:        schema.TypeString,
-							Description: "The type of DNS record.",
-							Computed:    true,
-						},
-						"host": {
-							Type:        schema.TypeString,
-							Description: "The domain that this CNAME is created for.",
-							Computed:    true,
-						},
-						"data": {
-							Type:        schema.TypeString,
-							Description: "The actual DNS record.",
-							Computed:    true,
-						},
-					},
-				},
+				Elem:        dnsRecordResource(),
 			},
+			"dns_records":         dnsRecordsSchema("mail_cname", "dkim1", "dkim2", "dkim", "mail_server", "subdomain_spf"),
+			"wait_for_validation": waitForValidationSchema(),
+			"on_behalf_of":        onBehalfOfSchema(),
 		},
 	}
 }
 
+// validateDomainAuthentication returns the validateFunc of the domain authentication of d.
+func validateDomainAuthentication(c *sendgrid.Client, d *schema.ResourceData) validateFunc {
+	return func(ctx context.Context) (bool, map[string]sendgrid.ValidationResult, error) {
+		validation, err := c.ValidateDomainAuthentication(ctx, d.Id())
+		if err.Err != nil {
+			return false, nil, err.Err
+		}
+
+		return validation.Valid, validation.ValidationResults, nil
+	}
+}
+
 func resourceSendgridDomainAuthenticationCreate(
 	ctx context.Context,
 	d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	domain := d.Get("domain").(string)
 	subdomain := d.Get("subdomain").(string)
@@ -147,28 +148,28 @@ func resourceSendgridDomainAuthenticationCreate(
 		ips = append(ips, ip.(string))
 	}
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateDomainAuthentication(
-			ctx,
-			domain,
-			subdomain,
-			ips,
-			customSPF,
-			isDefault,
-			automaticSecurity,
-			customDKIMSelector,
-		)
-	})
-
-	auth := apiKeyStruct.(*sendgrid.DomainAuthentication)
-
-	if err != nil {
-		return diag.FromErr(err)
+	auth, err := c.CreateDomainAuthentication(
+		ctx,
+		domain,
+		subdomain,
+		ips,
+		customSPF,
+		isDefault,
+		automaticSecurity,
+		customDKIMSelector,
+	)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	d.SetId(fmt.Sprint(auth.ID))
 
-	return resourceSendgridDomainAuthenticationRead(ctx, d, m)
+	diags := waitForValidation(ctx, d, d.Timeout(schema.TimeoutCreate), "domain authentication", validateDomainAuthentication(c, d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceSendgridDomainAuthenticationRead(ctx, d, m)...)
 }
 
 func resourceSendgridDomainAuthenticationRead( //nolint:funlen,cyclop
@@ -176,10 +177,11 @@ func resourceSendgridDomainAuthenticationRead( //nolint:funlen,cyclop
 	d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	auth, err := c.ReadDomainAuthentication(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return diagFromReadErr(d, err.Err)
 	}
 
 	//nolint:errcheck
@@ -265,6 +267,21 @@ func resourceSendgridDomainAuthenticationRead( //nolint:funlen,cyclop
 		return diag.FromErr(er)
 	}
 
+	records := map[string]interface{}{}
+	setDNSRecord(records, "mail_cname",
+		auth.DNS.MailCNAME.Valid, auth.DNS.MailCNAME.Type, auth.DNS.MailCNAME.Host, auth.DNS.MailCNAME.Data)
+	setDNSRecord(records, "dkim1", auth.DNS.DKIM1.Valid, auth.DNS.DKIM1.Type, auth.DNS.DKIM1.Host, auth.DNS.DKIM1.Data)
+	setDNSRecord(records, "dkim2", auth.DNS.DKIM2.Valid, auth.DNS.DKIM2.Type, auth.DNS.DKIM2.Host, auth.DNS.DKIM2.Data)
+	setDNSRecord(records, "dkim", auth.DNS.DKIM.Valid, auth.DNS.DKIM.Type, auth.DNS.DKIM.Host, auth.DNS.DKIM.Data)
+	setDNSRecord(records, "mail_server",
+		auth.DNS.MailServer.Valid, auth.DNS.MailServer.Type, auth.DNS.MailServer.Host, auth.DNS.MailServer.Data)
+	setDNSRecord(records, "subdomain_spf",
+		auth.DNS.SubDomainSPF.Valid, auth.DNS.SubDomainSPF.Type, auth.DNS.SubDomainSPF.Host, auth.DNS.SubDomainSPF.Data)
+
+	if er := d.Set("dns_records", records); er != nil {
+		return diag.FromErr(er)
+	}
+
 	return nil
 }
 
@@ -273,28 +290,37 @@ func resourceSendgridDomainAuthenticationUpdate(
 	d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	isDefault := d.Get("is_default").(bool)
 	customSPF := d.Get("custom_spf").(bool)
 
-	auth, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateDomainAuthentication(ctx, d.Id(), isDefault, customSPF)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	auth, err := c.UpdateDomainAuthentication(ctx, d.Id(), isDefault, customSPF)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
-	if !auth.(*sendgrid.DomainAuthentication).Valid && d.Get("valid").(bool) {
-		if err := c.ValidateDomainAuthentication(ctx, d.Id()); err.Err != nil || err.StatusCode != 200 {
-			if err.Err != nil {
-				return diag.FromErr(err.Err)
-			}
+	if !auth.Valid && d.Get("valid").(bool) {
+		validation, err := c.ValidateDomainAuthentication(ctx, d.Id())
+		if err.Err != nil {
+			return diagFromErr(err.Err)
+		}
 
-			return diag.Errorf("unable to validate domain DNS configuration")
+		if !validation.Valid {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "unable to validate domain DNS configuration",
+				Detail:   invalidRecords(validation.ValidationResults),
+			}}
 		}
 	}
 
-	return resourceSendgridDomainAuthenticationRead(ctx, d, m)
+	diags := waitForValidation(ctx, d, d.Timeout(schema.TimeoutUpdate), "domain authentication", validateDomainAuthentication(c, d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceSendgridDomainAuthenticationRead(ctx, d, m)...)
 }
 
 func resourceSendgridDomainAuthenticationDelete(
@@ -302,12 +328,11 @@ func resourceSendgridDomainAuthenticationDelete(
 	d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteDomainAuthentication(ctx, d.Id())
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.DeleteDomainAuthentication(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return nil
@@ -0,0 +1,60 @@
+/*
+Provide a resource to share a domain authentication of the parent account with a subuser.
+A subuser can only be associated with one domain authentication at a time.
+Example Usage
+```hcl
+
+	resource "sendgrid_domain_authentication" "default" {
+		domain = "example.com"
+	}
+
+	resource "sendgrid_subuser" "marketing" {
+		username = "marketing"
+		email    = "marketing@example.com"
+		password = "Passw0rd!"
+		ips      = ["127.0.0.1"]
+	}
+
+	resource "sendgrid_domain_authentication_subuser" "marketing" {
+		domain_id = sendgrid_domain_authentication.default.id
+		username  = sendgrid_subuser.marketing.username
+	}
+
+```
+Import
+A domain authentication subuser association can be imported, e.g.
+```sh
+$ terraform import sendgrid_domain_authentication_subuser.marketing <domain-id>/<username>
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridDomainAuthenticationSubuser() *schema.Resource {
+	return resourceSendgridSubuserAssociation(subuserAssociation{
+		object:    "domain authentication",
+		idKey:     "domain_id",
+		associate: (*sendgrid.Client).AssociateDomainAuthenticationWithSubuser,
+		read: func(c *sendgrid.Client, ctx context.Context, username string) (string, sendgrid.RequestError) {
+			auth, err := c.ReadSubuserDomainAuthentication(ctx, username)
+			if err.Err != nil {
+				return "", err
+			}
+
+			return fmt.Sprint(auth.ID), err
+		},
+		disassociate: func(c *sendgrid.Client, ctx context.Context, username string) sendgrid.RequestError {
+			_, err := c.DisassociateSubuserDomainAuthentication(ctx, username)
+
+			return err
+		},
+		errImportFormat: ErrInvalidDomainAuthenticationSubuserImportFormat,
+	})
+}
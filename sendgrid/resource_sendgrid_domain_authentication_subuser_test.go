@@ -0,0 +1,73 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridDomainAuthenticationSubuserBasic(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridDomainAuthenticationSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridDomainAuthenticationSubuserConfigBasic(domain, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_domain_authentication_subuser.this", "domain_id", "sendgrid_domain_authentication.this", "id"),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication_subuser.this", "username", username),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_domain_authentication_subuser.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridDomainAuthenticationSubuserDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_domain_authentication_subuser" {
+			continue
+		}
+
+		username := rs.Primary.ID[strings.Index(rs.Primary.ID, "/")+1:]
+
+		_, err := c.ReadSubuserDomainAuthentication(context.Background(), username)
+		if err.Err == nil {
+			return fmt.Errorf("domain authentication still associated with subuser %s", username)
+		}
+
+		if !errors.Is(err.Err, sendgrid.ErrNotFound) {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridDomainAuthenticationSubuserConfigBasic(domain, username string) string {
+	return testAccCheckSendgridDomainAuthenticationConfigBasic(domain, "") +
+		testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+			[]string{"127.0.0.1"}) + `
+resource "sendgrid_domain_authentication_subuser" "this" {
+  domain_id = sendgrid_domain_authentication.this.id
+  username  = sendgrid_subuser.this.username
+}`
+}
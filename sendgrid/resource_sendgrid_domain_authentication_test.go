@@ -0,0 +1,101 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+	"github.com/taharah/terraform-provider-sendgrid/sdk/sendgridtest"
+)
+
+func TestAccSendgridDomainAuthenticationWaitForValidation(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridDomainAuthenticationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridDomainAuthenticationConfigBasic(domain, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.this", "domain", domain),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.this", "valid", "false"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_domain_authentication.this", "dns_records.dkim1.host", "tf11._domainkey."+domain),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.this", "dns_records.mail_cname.type", "cname"),
+					resource.TestCheckNoResourceAttr("sendgrid_domain_authentication.this", "dns_records.dkim.host"),
+				),
+			},
+			{
+				// The DNS records don't exist, so the domain never becomes valid:
+				// the timeout is only a warning, which doesn't taint the domain.
+				Config: testAccCheckSendgridDomainAuthenticationConfigBasic(domain, `
+  wait_for_validation {
+    timeout       = "1s"
+    poll_interval = "100ms"
+  }`),
+				Check: resource.TestCheckResourceAttr("sendgrid_domain_authentication.this", "valid", "false"),
+			},
+		},
+	})
+}
+
+func TestAccSendgridDomainAuthenticationValidated(t *testing.T) {
+	if os.Getenv("SENDGRID_API_KEY") != sendgridtest.APIKey {
+		t.Skip("the DNS records of the domain must be published, only the fake Sendgrid API deems them so")
+	}
+
+	domain := "terraform-" + acctest.RandString(10) + "." + sendgridtest.PublishedDomain
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridDomainAuthenticationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridDomainAuthenticationConfigBasic(domain, `
+  wait_for_validation {
+    timeout       = "5s"
+    poll_interval = "100ms"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.this", "valid", "true"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_domain_authentication.this", "dns_records.dkim1.valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridDomainAuthenticationDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_domain_authentication" {
+			continue
+		}
+
+		if _, err := c.DeleteDomainAuthentication(context.Background(), rs.Primary.ID); err.Err != nil {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridDomainAuthenticationConfigBasic(domain, waitForValidation string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_domain_authentication" "this" {
+  domain               = %q
+  automatic_security   = true
+  custom_dkim_selector = "tf1"
+%s
+}`, domain, waitForValidation)
+}
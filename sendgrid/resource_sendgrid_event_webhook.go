@@ -1,9 +1,14 @@
 /*
-Provide a resource to manage an event webhook settings.
+Provide a resource to manage an event webhook.
+An account can have several event webhooks, each posting the events it selects to its own URL.
+Deleting the resource deletes the event webhook.
+An event webhook managed by a version of the provider predating multiple event webhooks
+is migrated to the oldest event webhook of its account, which is the one it managed.
 Example Usage
 ```hcl
 
 	resource "sendgrid_event_webhook" "default" {
+		friendly_name = "analytics"
 		enabled = true
 	    url = "https://foo.bar/sendgrid/inbound"
 	    group_resubscribe = true
@@ -20,221 +25,568 @@ Example Usage
 	    oauth_client_id = "a-client-id"
 	    oauth_client_secret = "a-client-secret"
 	    oauth_token_url = "https://oauth.example.com/token"
+	    test_on_apply = true
 	}
 
+```
+Import
+An event webhook can be imported by its ID, a UUID, e.g.
+```hcl
+$ terraform import sendgrid_event_webhook.default webhookId
+```
+The oldest event webhook of the parent account can be imported by `default`,
+and the one of a subuser by its username, e.g.
+```hcl
+$ terraform import sendgrid_event_webhook.default default
+$ terraform import sendgrid_event_webhook.marketing marketing
+```
+Any event webhook of a subuser can be imported by its username and ID, e.g.
+```hcl
+$ terraform import sendgrid_event_webhook.marketing marketing/webhookId
 ```
 */
 package sendgrid
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
 )
 
+// defaultSigningKeyGracePeriod is how long the previous public key is exposed after rotating the signing key.
+const defaultSigningKeyGracePeriod = "24h"
+
+// eventWebhookID matches the IDs of the event webhooks, UUIDs, to tell them apart from usernames on import.
+var eventWebhookID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
 func resourceSendgridEventWebhook() *schema.Resource { //nolint:funlen
+	s := resourceSendgridEventWebhookSchema()
+	s["friendly_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "A name to tell the event webhook apart from the other ones of the account.",
+		Optional:    true,
+	}
+	s["rotate_signing_key"] = &schema.Schema{
+		Type: schema.TypeString,
+		Description: "Change this value, e.g. to the current date, to replace the key pair signing the events " +
+			"of a signed event webhook. The previous public key stays available in `previous_public_key` " +
+			"for `signing_key_grace_period`, so that receivers can accept the events posted in the meantime.",
+		Optional: true,
+	}
+	s["signing_key_grace_period"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "How long the previous public key stays available after rotating the signing key, as a duration.",
+		Optional:     true,
+		Default:      defaultSigningKeyGracePeriod,
+		ValidateFunc: validateDuration,
+	}
+	s["test_on_apply"] = &schema.Schema{
+		Type: schema.TypeBool,
+		Description: "Should Sendgrid post a test event to the event webhook after it is created or updated? " +
+			"The apply fails with the response of the endpoint if the test event can't be delivered.",
+		Optional: true,
+	}
+	s["previous_public_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The public key used to sign the event webhook before the signing key was last rotated.",
+		Computed:    true,
+	}
+	s["previous_public_key_expires_at"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "When `previous_public_key` stops being exposed, in RFC 3339 format.",
+		Computed:    true,
+	}
+
 	return &schema.Resource{
-		CreateContext: resourceSendgridEventWebhookPatch,
+		CreateContext: resourceSendgridEventWebhookCreate,
 		ReadContext:   resourceSendgridEventWebhookRead,
-		UpdateContext: resourceSendgridEventWebhookPatch,
+		UpdateContext: resourceSendgridEventWebhookUpdate,
 		DeleteContext: resourceSendgridEventWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridEventWebhookImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{{
+			Version: 0,
+			Type:    resourceSendgridEventWebhookV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceSendgridEventWebhookStateUpgradeV0,
+		}},
+
+		CustomizeDiff: resourceSendgridEventWebhookCustomizeDiff,
+
+		Schema: s,
+	}
+}
+
+// resourceSendgridEventWebhookCustomizeDiff plans the keys that change along with the signing settings.
+func resourceSendgridEventWebhookCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("signed") {
+		if err := d.SetNewComputed("public_key"); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("rotate_signing_key") && d.Get("signed").(bool) && !d.HasChange("signed") {
+		for _, key := range []string{"public_key", "previous_public_key", "previous_public_key_expires_at"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
 
+	return nil
+}
+
+// resourceSendgridEventWebhookV0 is the event webhook resource predating multiple event webhooks,
+// whose ID was the subuser it was managed on behalf of, or "default" for the parent account.
+// Its schema is frozen: it must keep describing the version 0 states, whatever the current schema becomes.
+func resourceSendgridEventWebhookV0() *schema.Resource { //nolint:funlen
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enabled": {
-				Type:        schema.TypeBool,
-				Description: "Indicates if the event webhook is enabled.",
-				Required:    true,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"url": {
-				Type: schema.TypeString,
-				Description: "The public URL where you would like SendGrid to POST the data events from your email. " +
-					"Any emails sent with the given hostname provided (whose MX records have been updated to point to SendGrid) " +
-					"will be eventd and POSTed to this URL.",
+				Type:     schema.TypeString,
 				Required: true,
 			},
 			"group_resubscribe": {
-				Type: schema.TypeBool,
-				Description: "Recipient resubscribes to specific group by updating preferences. " +
-					"You need to enable Subscription Tracking for getting this type of event.",
+				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 			"delivered": {
-				Type:        schema.TypeBool,
-				Description: "Message has been successfully delivered to the receiving server.",
-				Optional:    true,
-				Default:     true,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"group_unsubscribe": {
-				Type: schema.TypeBool,
-				Description: "Recipient unsubscribe from specific group, by either direct link or updating preferences. " +
-					"You need to enable Subscription Tracking for getting this type of event.",
+				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 			"spam_report": {
-				Type:        schema.TypeBool,
-				Description: "Recipient marked a message as spam.",
-				Optional:    true,
-				Default:     true,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"bounce": {
-				Type:        schema.TypeBool,
-				Description: "Receiving server could not or would not accept message.",
-				Optional:    true,
-				Default:     true,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"deferred": {
-				Type:        schema.TypeBool,
-				Description: "Recipient's email server temporarily rejected message.",
-				Optional:    true,
-				Default:     true,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"unsubscribe": {
-				Type: schema.TypeBool,
-				Description: "Recipient clicked on message's subscription management link. " +
-					"You need to enable Subscription Tracking for getting this type of event.",
+				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 			"processed": {
-				Type:        schema.TypeBool,
-				Description: "Message has been received and is ready to be delivered.",
-				Optional:    true,
-				Default:     true,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"open": {
-				Type: schema.TypeBool,
-				Description: "Recipient has opened the HTML message. " +
-					"You need to enable Open Tracking for getting this type of event.",
+				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 			"click": {
-				Type: schema.TypeBool,
-				Description: "Recipient clicked on a link within the message. " +
-					"You need to enable Click Tracking for getting this type of event.",
+				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 			"dropped": {
-				Type: schema.TypeBool,
-				Description: "You may see the following drop reasons: " +
-					"Invalid SMTPAPI header, Spam Content (if spam checker app enabled), " +
-					"Unsubscribed Address, Bounced Address, Spam Reporting Address, Invalid, Recipient List over Package Quota.",
+				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  true,
 			},
 			"oauth_client_id": {
-				Type: schema.TypeString,
-				Description: "The client ID Twilio SendGrid sends to your OAuth server or " +
-					"service provider to generate an OAuth access token.",
+				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"oauth_client_secret": {
-				Type: schema.TypeString,
-				Description: "This secret is needed only once to create an access token. SendGrid will store this secret, " +
-					"allowing you to update your Client ID and Token URL without passing the secret to SendGrid again. " +
-					"When passing data in this field, you must also include the oauth_client_id and oauth_token_url fields.",
+				Type:      schema.TypeString,
 				Optional:  true,
 				Sensitive: true,
 			},
 			"oauth_token_url": {
-				Type: schema.TypeString,
-				Description: "The URL where Twilio SendGrid sends the Client ID and Client Secret to generate an access token. " +
-					"This should be your OAuth server or service provider. " +
-					"When passing data in this field, you must also include the oauth_client_id field.",
+				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"signed": {
-				Type:        schema.TypeBool,
-				Description: "Should the event webhook use signing?",
-				Optional:    true,
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 			"public_key": {
-				Type:        schema.TypeString,
-				Description: "The public key used to sign the event webhook. Only present if 'signed' is true",
-				Computed:    true,
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"on_behalf_of": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
 			},
 		},
 	}
 }
 
-func resourceSendgridEventWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// no op as there is no way to delete it
-	return nil
+// resourceSendgridEventWebhookStateUpgradeV0 identifies the event webhook of a version 0 state by the ID
+// of the oldest event webhook of its account, which the legacy event webhook endpoints act on.
+func resourceSendgridEventWebhookStateUpgradeV0(
+	ctx context.Context,
+	rawState map[string]interface{},
+	m interface{},
+) (map[string]interface{}, error) {
+	c := m.(*sendgrid.Client)
+
+	onBehalfOf, _ := rawState["on_behalf_of"].(string)
+
+	webhook, err := c.ReadEventWebhook(sendgrid.WithOnBehalfOf(ctx, onBehalfOf))
+	if err.Err != nil {
+		return nil, err.Err
+	}
+
+	if webhook.ID == "" {
+		return nil, fmt.Errorf("%w: %v", ErrLegacyEventWebhookNotFound, rawState["id"])
+	}
+
+	rawState["id"] = webhook.ID
+
+	return rawState, nil
+}
+
+func resourceSendgridEventWebhookSchema() map[string]*schema.Schema { //nolint:funlen
+	return map[string]*schema.Schema{
+		"enabled": {
+			Type:        schema.TypeBool,
+			Description: "Indicates if the event webhook is enabled.",
+			Required:    true,
+		},
+		"url": {
+			Type: schema.TypeString,
+			Description: "The public URL where you would like SendGrid to POST the data events from your email. " +
+				"Any emails sent with the given hostname provided (whose MX records have been updated to point to SendGrid) " +
+				"will be eventd and POSTed to this URL.",
+			Required: true,
+		},
+		"group_resubscribe": {
+			Type: schema.TypeBool,
+			Description: "Recipient resubscribes to specific group by updating preferences. " +
+				"You need to enable Subscription Tracking for getting this type of event.",
+			Optional: true,
+			Default:  true,
+		},
+		"delivered": {
+			Type:        schema.TypeBool,
+			Description: "Message has been successfully delivered to the receiving server.",
+			Optional:    true,
+			Default:     true,
+		},
+		"group_unsubscribe": {
+			Type: schema.TypeBool,
+			Description: "Recipient unsubscribe from specific group, by either direct link or updating preferences. " +
+				"You need to enable Subscription Tracking for getting this type of event.",
+			Optional: true,
+			Default:  true,
+		},
+		"spam_report": {
+			Type:        schema.TypeBool,
+			Description: "Recipient marked a message as spam.",
+			Optional:    true,
+			Default:     true,
+		},
+		"bounce": {
+			Type:        schema.TypeBool,
+			Description: "Receiving server could not or would not accept message.",
+			Optional:    true,
+			Default:     true,
+		},
+		"deferred": {
+			Type:        schema.TypeBool,
+			Description: "Recipient's email server temporarily rejected message.",
+			Optional:    true,
+			Default:     true,
+		},
+		"unsubscribe": {
+			Type: schema.TypeBool,
+			Description: "Recipient clicked on message's subscription management link. " +
+				"You need to enable Subscription Tracking for getting this type of event.",
+			Optional: true,
+			Default:  true,
+		},
+		"processed": {
+			Type:        schema.TypeBool,
+			Description: "Message has been received and is ready to be delivered.",
+			Optional:    true,
+			Default:     true,
+		},
+		"open": {
+			Type: schema.TypeBool,
+			Description: "Recipient has opened the HTML message. " +
+				"You need to enable Open Tracking for getting this type of event.",
+			Optional: true,
+			Default:  true,
+		},
+		"click": {
+			Type: schema.TypeBool,
+			Description: "Recipient clicked on a link within the message. " +
+				"You need to enable Click Tracking for getting this type of event.",
+			Optional: true,
+			Default:  true,
+		},
+		"dropped": {
+			Type: schema.TypeBool,
+			Description: "You may see the following drop reasons: " +
+				"Invalid SMTPAPI header, Spam Content (if spam checker app enabled), " +
+				"Unsubscribed Address, Bounced Address, Spam Reporting Address, Invalid, Recipient List over Package Quota.",
+			Optional: true,
+			Default:  true,
+		},
+		"oauth_client_id": {
+			Type: schema.TypeString,
+			Description: "The client ID Twilio SendGrid sends to your OAuth server or " +
+				"service provider to generate an OAuth access token.",
+			Optional: true,
+		},
+		"oauth_client_secret": {
+			Type: schema.TypeString,
+			Description: "This secret is needed only once to create an access token. SendGrid will store this secret, " +
+				"allowing you to update your Client ID and Token URL without passing the secret to SendGrid again. " +
+				"When passing data in this field, you must also include the oauth_client_id and oauth_token_url fields.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"oauth_token_url": {
+			Type: schema.TypeString,
+			Description: "The URL where Twilio SendGrid sends the Client ID and Client Secret to generate an access token. " +
+				"This should be your OAuth server or service provider. " +
+				"When passing data in this field, you must also include the oauth_client_id field.",
+			Optional: true,
+		},
+		"signed": {
+			Type:        schema.TypeBool,
+			Description: "Should the event webhook use signing?",
+			Optional:    true,
+		},
+		"public_key": {
+			Type:        schema.TypeString,
+			Description: "The public key used to sign the event webhook. Only present if 'signed' is true",
+			Computed:    true,
+		},
+		"on_behalf_of": onBehalfOfSchema(),
+	}
 }
 
-func resourceSendgridEventWebhookPatch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// resourceSendgridEventWebhookImport imports an event webhook by its ID, a UUID, or the oldest event webhook
+// of an account, the one the legacy event webhook endpoints act on, by "default" for the parent account
+// or by the username of a subuser. The event webhook of a subuser can also be imported by <username>/<ID>.
+func resourceSendgridEventWebhookImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) ([]*schema.ResourceData, error) {
 	c := m.(*sendgrid.Client)
 
-	enabled := d.Get("enabled").(bool)
-	url := d.Get("url").(string)
-	groupResubscribe := d.Get("group_resubscribe").(bool)
-	delivered := d.Get("delivered").(bool)
-	groupUnsubscribe := d.Get("group_unsubscribe").(bool)
-	spamReport := d.Get("spam_report").(bool)
-	bounce := d.Get("bounce").(bool)
-	deferred := d.Get("deferred").(bool)
-	unsubscribe := d.Get("unsubscribe").(bool)
-	processed := d.Get("processed").(bool)
-	open := d.Get("open").(bool)
-	click := d.Get("click").(bool)
-	dropped := d.Get("dropped").(bool)
-	oauthClientID := d.Get("oauth_client_id").(string)
-	oauthClientSecret := d.Get("oauth_client_secret").(string)
-	oauthTokenURL := d.Get("oauth_token_url").(string)
-
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.PatchEventWebhook(
-			ctx,
-			enabled,
-			url,
-			groupResubscribe,
-			delivered,
-			groupUnsubscribe,
-			spamReport,
-			bounce,
-			deferred,
-			unsubscribe,
-			processed,
-			open,
-			click,
-			dropped,
-			oauthClientID,
-			oauthClientSecret,
-			oauthTokenURL,
-		)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	// Defaults aren't applied to imported resources.
+	//nolint:errcheck
+	d.Set("signing_key_grace_period", defaultSigningKeyGracePeriod)
+
+	if username, id, ok := strings.Cut(d.Id(), "/"); ok {
+		if username == "" || !eventWebhookID.MatchString(id) {
+			return nil, ErrInvalidEventWebhookImportFormat
+		}
+
+		//nolint:errcheck
+		d.Set("on_behalf_of", username)
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if eventWebhookID.MatchString(d.Id()) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if d.Id() != "default" {
+		//nolint:errcheck
+		d.Set("on_behalf_of", d.Id())
+	}
+
+	webhook, err := c.ReadEventWebhook(withOnBehalfOf(ctx, d))
+	if err.Err != nil {
+		return nil, err.Err
+	}
+
+	if webhook.ID == "" {
+		return nil, fmt.Errorf("%w: %s", ErrEventWebhookNotFound, d.Id())
+	}
+
+	d.SetId(webhook.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func eventWebhookFromResourceData(d *schema.ResourceData) sendgrid.EventWebhook {
+	return sendgrid.EventWebhook{
+		FriendlyName:      d.Get("friendly_name").(string),
+		Enabled:           d.Get("enabled").(bool),
+		URL:               d.Get("url").(string),
+		GroupResubscribe:  d.Get("group_resubscribe").(bool),
+		Delivered:         d.Get("delivered").(bool),
+		GroupUnsubscribe:  d.Get("group_unsubscribe").(bool),
+		SpamReport:        d.Get("spam_report").(bool),
+		Bounce:            d.Get("bounce").(bool),
+		Deferred:          d.Get("deferred").(bool),
+		Unsubscribe:       d.Get("unsubscribe").(bool),
+		Processed:         d.Get("processed").(bool),
+		Open:              d.Get("open").(bool),
+		Click:             d.Get("click").(bool),
+		Dropped:           d.Get("dropped").(bool),
+		OAuthClientID:     d.Get("oauth_client_id").(string),
+		OAuthClientSecret: d.Get("oauth_client_secret").(string),
+		OAuthTokenURL:     d.Get("oauth_token_url").(string),
+	}
+}
+
+func resourceSendgridEventWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	webhook, err := c.CreateEventWebhook(ctx, eventWebhookFromResourceData(d))
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(webhook.ID)
+
+	if d.Get("signed").(bool) {
+		if _, err := c.ConfigureEventWebhookSigning(ctx, d.Id(), true); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	if diags := resourceSendgridEventWebhookRead(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return testEventWebhook(ctx, c, d)
+}
+
+func resourceSendgridEventWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	if _, err := c.UpdateEventWebhook(ctx, d.Id(), eventWebhookFromResourceData(d)); err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	if d.HasChange("signed") {
-		if _, err := c.ConfigureEventWebhookSigning(ctx, d.Get("signed").(bool)); err.Err != nil {
-			return diag.FromErr(err.Err)
+		if _, err := c.ConfigureEventWebhookSigning(ctx, d.Id(), d.Get("signed").(bool)); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	} else if d.HasChange("rotate_signing_key") && d.Get("signed").(bool) {
+		if diags := rotateEventWebhookSigningKey(ctx, c, d); diags.HasError() {
+			return diags
+		}
+	}
+
+	if diags := resourceSendgridEventWebhookRead(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return testEventWebhook(ctx, c, d)
+}
+
+// testEventWebhook posts a test event to the event webhook of d when test_on_apply is set,
+// reporting on the url attribute why it couldn't be delivered.
+func testEventWebhook(ctx context.Context, c *sendgrid.Client, d *schema.ResourceData) diag.Diagnostics {
+	if !d.Get("test_on_apply").(bool) {
+		return nil
+	}
+
+	webhook := eventWebhookFromResourceData(d)
+	webhook.ID = d.Id()
+
+	if err := c.TestEventWebhook(ctx, webhook); err.Err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("unable to deliver a test event to %s", webhook.URL),
+			Detail:        err.Err.Error(),
+			AttributePath: cty.GetAttrPath("url"),
+		}}
+	}
+
+	return nil
+}
+
+// rotateEventWebhookSigningKey replaces the key pair of the event webhook of d by disabling then enabling
+// its signature, keeping track of the previous public key until the grace period ends.
+func rotateEventWebhookSigningKey(ctx context.Context, c *sendgrid.Client, d *schema.ResourceData) diag.Diagnostics {
+	gracePeriod, er := time.ParseDuration(d.Get("signing_key_grace_period").(string))
+	if er != nil {
+		return diag.FromErr(er)
+	}
+
+	previousPublicKey := d.Get("public_key").(string)
+
+	if _, err := c.ConfigureEventWebhookSigning(ctx, d.Id(), false); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	if _, err := c.ConfigureEventWebhookSigning(ctx, d.Id(), true); err.Err != nil {
+		if diags := unsignedEventWebhookDiags(ctx, c, d, err.Err); diags.HasError() {
+			return diags
 		}
 	}
 
-	if c.OnBehalfOf != "" {
-		d.SetId(c.OnBehalfOf) // since there is only a global event webhook per subuser
-	} else {
-		d.SetId("default") // or at the parent account level
+	//nolint:errcheck
+	d.Set("previous_public_key", previousPublicKey)
+	//nolint:errcheck
+	d.Set("previous_public_key_expires_at", time.Now().Add(gracePeriod).UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceSendgridEventWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	if _, err := c.DeleteEventWebhook(ctx, d.Id()); err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
-	return resourceSendgridEventWebhookRead(ctx, d, m)
+	return nil
 }
 
 func resourceSendgridEventWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
-	webhook, err := c.ReadEventWebhook(ctx)
+	webhook, err := c.ReadEventWebhookByID(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return diagFromReadErr(d, err.Err)
 	}
 
+	//nolint:errcheck
+	d.Set("friendly_name", webhook.FriendlyName)
 	//nolint:errcheck
 	d.Set("enabled", webhook.Enabled)
 	//nolint:errcheck
@@ -250,7 +602,7 @@ func resourceSendgridEventWebhookRead(ctx context.Context, d *schema.ResourceDat
 	//nolint:errcheck
 	d.Set("bounce", webhook.Bounce)
 	//nolint:errcheck
-	d.Set("deferred", webhook.Delivered)
+	d.Set("deferred", webhook.Deferred)
 	//nolint:errcheck
 	d.Set("unsubscribe", webhook.Unsubscribe)
 	//nolint:errcheck
@@ -266,14 +618,54 @@ func resourceSendgridEventWebhookRead(ctx context.Context, d *schema.ResourceDat
 	//nolint:errcheck
 	d.Set("oauth_token_url", webhook.OAuthTokenURL)
 
-	webhookSigning, err := c.ReadEventWebhookSigning(ctx)
+	webhookSigning, err := c.ReadEventWebhookSigning(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return diagFromErr(err.Err)
 	}
 	//nolint:errcheck
 	d.Set("public_key", webhookSigning.PublicKey)
+
+	// The previous public key isn't known to Sendgrid, it is only kept in the state.
+	if expiresAt, er := time.Parse(time.RFC3339, d.Get("previous_public_key_expires_at").(string)); er == nil &&
+		time.Now().After(expiresAt) {
+		//nolint:errcheck
+		d.Set("previous_public_key", "")
+		//nolint:errcheck
+		d.Set("previous_public_key_expires_at", "")
+	}
 	//nolint:errcheck
 	d.Set("signed", webhookSigning.PublicKey != "")
 
 	return nil
 }
+
+// unsignedEventWebhookDiags reports that the signature of the event webhook of d couldn't be enabled again
+// while rotating its signing key, the client having already retried the transient errors. The state is set
+// to the signature read back from Sendgrid, so that the next apply signs the event webhook again;
+// nothing is reported if the event webhook turns out to be signed anyway.
+func unsignedEventWebhookDiags(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	signingErr error,
+) diag.Diagnostics {
+	publicKey := ""
+	if webhookSigning, err := c.ReadEventWebhookSigning(ctx, d.Id()); err.Err == nil {
+		publicKey = webhookSigning.PublicKey
+	}
+
+	//nolint:errcheck
+	d.Set("public_key", publicKey)
+	//nolint:errcheck
+	d.Set("signed", publicKey != "")
+
+	if publicKey != "" {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("event webhook %s is unsigned: its signing key was revoked but no new one could be created", d.Id()),
+		Detail:   signingErr.Error(),
+	}}
+}
@@ -0,0 +1,383 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridEventWebhookBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookConfigBasic("analytics", "https://example.org/events", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook.this", "id"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "friendly_name", "analytics"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "signed", "true"),
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook.this", "public_key"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.other", "url", "https://example.org/other"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.other", "signed", "false"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridEventWebhookConfigBasic("billing", "https://example.org/billing", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "friendly_name", "billing"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "url", "https://example.org/billing"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "signed", "false"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "public_key", ""),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_event_webhook.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridEventWebhookImportByAccount(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookConfigAccounts(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.subuser", "on_behalf_of", username),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.subuser", "signed", "true"),
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook.subuser", "public_key"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_event_webhook.default",
+				ImportState:       true,
+				ImportStateId:     "default",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_event_webhook.subuser",
+				ImportState:       true,
+				ImportStateId:     username,
+				ImportStateVerify: true,
+				// The OAuth client secret is never returned by the API.
+				ImportStateVerifyIgnore: []string{"oauth_client_secret"},
+			},
+			{
+				ResourceName:            "sendgrid_event_webhook.subuser",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccSendgridSubuserImportID("sendgrid_event_webhook.subuser"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oauth_client_secret"},
+			},
+			{
+				// A UUID is the ID of an event webhook, never a username.
+				ResourceName:  "sendgrid_event_webhook.default",
+				ImportState:   true,
+				ImportStateId: "00000000-0000-4000-8000-ffffffffffff",
+				ExpectError:   regexp.MustCompile(`Cannot import non-existent remote object`),
+			},
+			{
+				ResourceName:  "sendgrid_event_webhook.subuser",
+				ImportState:   true,
+				ImportStateId: username + "/default",
+				ExpectError:   regexp.MustCompile(`invalid import`),
+			},
+		},
+	})
+}
+
+// testAccSendgridSubuserImportID returns the <username>/<ID> import ID of n.
+func testAccSendgridSubuserImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["on_behalf_of"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+func TestAccSendgridEventWebhookTestOnApply(t *testing.T) {
+	var received int32
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&received, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer rejecting.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookConfigTestOnApply(receiver.URL),
+				Check: func(*terraform.State) error {
+					if n := atomic.LoadInt32(&received); n != 1 {
+						return fmt.Errorf("expected 1 test event to be delivered, got %d", n)
+					}
+
+					return nil
+				},
+			},
+			{
+				Config:      testAccCheckSendgridEventWebhookConfigTestOnApply(rejecting.URL),
+				ExpectError: regexp.MustCompile(`unable to deliver a test event(.|\n)*401 Unauthorized`),
+			},
+		},
+	})
+}
+
+func TestAccSendgridEventWebhookRotateSigningKey(t *testing.T) {
+	var publicKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookConfigRotate("1", "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridEventWebhookPublicKey("sendgrid_event_webhook.this", &publicKey),
+					resource.TestCheckNoResourceAttr("sendgrid_event_webhook.this", "previous_public_key"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridEventWebhookConfigRotate("2", "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						previous := publicKey
+						if err := testAccCheckSendgridEventWebhookPublicKey("sendgrid_event_webhook.this", &publicKey)(s); err != nil {
+							return err
+						}
+
+						if publicKey == previous {
+							return fmt.Errorf("expected the public key to be rotated, still %s", publicKey)
+						}
+
+						return resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "previous_public_key", previous)(s)
+					},
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook.this", "previous_public_key_expires_at"),
+				),
+			},
+			{
+				// Without grace period, the previous public key expires right away.
+				Config: testAccCheckSendgridEventWebhookConfigRotate("3", "0s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "previous_public_key", ""),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "previous_public_key_expires_at", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridEventWebhookRotateSigningKeyFailure(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("the failure is injected in the fake Sendgrid API")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookConfigRotate("1", "1h"),
+				Check:  resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "signed", "true"),
+			},
+			{
+				// The signature is disabled, then enabling it again fails.
+				PreConfig: func() {
+					testAccServer.FailRequest(
+						http.MethodPatch, "/user/webhooks/event/settings/signed/{id}", 2, http.StatusBadRequest)
+				},
+				Config:      testAccCheckSendgridEventWebhookConfigRotate("2", "1h"),
+				ExpectError: regexp.MustCompile(`event webhook .* is unsigned`),
+			},
+			{
+				// The state records the event webhook as unsigned, so it is planned to be signed again.
+				Config:             testAccCheckSendgridEventWebhookConfigRotate("2", "1h"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckSendgridEventWebhookConfigRotate("2", "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.this", "signed", "true"),
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook.this", "public_key"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckSendgridEventWebhookPublicKey stores the public key of n in publicKey.
+func testAccCheckSendgridEventWebhookPublicKey(n string, publicKey *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["public_key"] == "" {
+			return fmt.Errorf("no public key set for %s", n)
+		}
+
+		*publicKey = rs.Primary.Attributes["public_key"]
+
+		return nil
+	}
+}
+
+func TestAccSendgridEventWebhookStateUpgradeV0(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sendgrid_event_webhook" "this" {
+  enabled = true
+  url     = "https://example.org/events"
+}`,
+				Check: testAccCheckSendgridEventWebhookStateUpgradeV0("sendgrid_event_webhook.this"),
+			},
+		},
+	})
+}
+
+// testAccCheckSendgridEventWebhookStateUpgradeV0 checks that the state of the singleton event webhook
+// of the account is migrated to the ID of n, the only event webhook of the account.
+func testAccCheckSendgridEventWebhookStateUpgradeV0(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		upgrader := testAccProvider.ResourcesMap["sendgrid_event_webhook"].StateUpgraders[0]
+
+		state, err := upgrader.Upgrade(context.Background(), map[string]interface{}{
+			"id":      "default",
+			"enabled": true,
+			"url":     "https://example.org/events",
+		}, testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		if state["id"] != rs.Primary.ID {
+			return fmt.Errorf("expected the state to be upgraded to ID %s, got %v", rs.Primary.ID, state["id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridEventWebhookDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_event_webhook" {
+			continue
+		}
+
+		_, err := c.ReadEventWebhookByID(context.Background(), rs.Primary.ID)
+		if err.Err == nil {
+			return fmt.Errorf("event webhook %s still exists", rs.Primary.ID)
+		}
+
+		if !errors.Is(err.Err, sendgrid.ErrNotFound) {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridEventWebhookConfigBasic(friendlyName, url string, signed bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_event_webhook" "this" {
+  friendly_name = %q
+  enabled       = true
+  url           = %q
+  signed        = %t
+}
+
+resource "sendgrid_event_webhook" "other" {
+  enabled = false
+  url     = "https://example.org/other"
+  open    = false
+}`, friendlyName, url, signed)
+}
+
+func testAccCheckSendgridEventWebhookConfigRotate(rotation, gracePeriod string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_event_webhook" "this" {
+  enabled = true
+  url     = "https://example.org/events"
+  signed  = true
+
+  rotate_signing_key       = %q
+  signing_key_grace_period = %q
+}`, rotation, gracePeriod)
+}
+
+func testAccCheckSendgridEventWebhookConfigAccounts(username string) string {
+	return testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+		[]string{"127.0.0.1"}) + `
+resource "sendgrid_event_webhook" "default" {
+  enabled = true
+  url     = "https://example.org/events"
+}
+
+resource "sendgrid_event_webhook" "subuser" {
+  on_behalf_of = sendgrid_subuser.this.username
+
+  friendly_name       = "subuser"
+  enabled             = true
+  url                 = "https://example.org/subuser"
+  signed              = true
+  oauth_client_id     = "a-client-id"
+  oauth_client_secret = "a-client-secret"
+  oauth_token_url     = "https://oauth.example.org/token"
+}`
+}
+
+func testAccCheckSendgridEventWebhookConfigTestOnApply(url string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_event_webhook" "this" {
+  enabled       = true
+  url           = %q
+  test_on_apply = true
+}`, url)
+}
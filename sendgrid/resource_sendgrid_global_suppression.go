@@ -0,0 +1,182 @@
+/*
+Provide a resource to manage recipients unsubscribed from every email of the account.
+
+**Note** Only the emails listed in the resource are managed: the recipients who unsubscribed by themselves
+are left alone. New emails are added in a single request, removed ones are resubscribed one by one.
+Example Usage
+```hcl
+
+	resource "sendgrid_global_suppression" "compliance" {
+		emails = [
+			"do-not-contact@example.com",
+			"test-inbox@example.com",
+		]
+	}
+
+```
+Import
+The global suppressions of the parent account can be imported by `global`, and the ones of a subuser
+by its username. Every recipient unsubscribed from the account is then managed, e.g.
+```sh
+$ terraform import sendgrid_global_suppression.compliance global
+$ terraform import sendgrid_global_suppression.marketing marketing
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+// globalSuppressionID is the ID of the global suppressions of the parent account.
+const globalSuppressionID = "global"
+
+func resourceSendgridGlobalSuppression() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridGlobalSuppressionCreate,
+		ReadContext:   resourceSendgridGlobalSuppressionRead,
+		UpdateContext: resourceSendgridGlobalSuppressionUpdate,
+		DeleteContext: resourceSendgridGlobalSuppressionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridGlobalSuppressionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emails": {
+				Type:        schema.TypeSet,
+				Description: "The emails unsubscribed from every email of the account.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"on_behalf_of": onBehalfOfSchema(),
+		},
+	}
+}
+
+// resourceSendgridGlobalSuppressionImport manages every email suppressed from the account,
+// the only time the full list is read: refreshing only ever looks up the managed emails.
+func resourceSendgridGlobalSuppressionImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) ([]*schema.ResourceData, error) {
+	c := m.(*sendgrid.Client)
+
+	if d.Id() != globalSuppressionID {
+		//nolint:errcheck
+		d.Set("on_behalf_of", d.Id())
+	}
+
+	suppressions, err := c.ReadGlobalSuppressions(withOnBehalfOf(ctx, d))
+	if err.Err != nil {
+		return nil, err.Err
+	}
+
+	emails := make([]string, 0, len(suppressions))
+	for _, suppression := range suppressions {
+		emails = append(emails, suppression.Email)
+	}
+
+	//nolint:errcheck
+	d.Set("emails", emails)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSendgridGlobalSuppressionCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	if _, err := c.AddGlobalSuppressions(ctx, stringSetToSlice(d.Get("emails").(*schema.Set))); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	if onBehalfOf := d.Get("on_behalf_of").(string); onBehalfOf != "" {
+		d.SetId(onBehalfOf)
+	} else {
+		d.SetId(globalSuppressionID)
+	}
+
+	return resourceSendgridGlobalSuppressionRead(ctx, d, m)
+}
+
+func resourceSendgridGlobalSuppressionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	managed := stringSetToSlice(d.Get("emails").(*schema.Set))
+	emails := make([]string, 0, len(managed))
+
+	for _, email := range managed {
+		suppressed, err := c.ReadGlobalSuppression(ctx, email)
+		if err.Err != nil {
+			return diagFromReadErr(d, err.Err)
+		}
+
+		if suppressed {
+			emails = append(emails, email)
+		}
+	}
+
+	//nolint:errcheck
+	d.Set("emails", emails)
+
+	return nil
+}
+
+func resourceSendgridGlobalSuppressionUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	o, n := d.GetChange("emails")
+	oldEmails := o.(*schema.Set)
+	newEmails := n.(*schema.Set)
+
+	for _, email := range stringSetToSlice(oldEmails.Difference(newEmails)) {
+		if _, err := c.DeleteGlobalSuppression(ctx, email); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	if added := stringSetToSlice(newEmails.Difference(oldEmails)); len(added) > 0 {
+		if _, err := c.AddGlobalSuppressions(ctx, added); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	return resourceSendgridGlobalSuppressionRead(ctx, d, m)
+}
+
+func resourceSendgridGlobalSuppressionDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	for _, email := range stringSetToSlice(d.Get("emails").(*schema.Set)) {
+		if _, err := c.DeleteGlobalSuppression(ctx, email); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	return nil
+}
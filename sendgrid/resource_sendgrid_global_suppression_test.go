@@ -0,0 +1,133 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridGlobalSuppressionBasic(t *testing.T) {
+	unmanaged := "unsubscribed-by-themselves@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridGlobalSuppressionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridGlobalSuppressionConfigBasic("a@example.org", "b@example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_global_suppression.this", "id", "global"),
+					resource.TestCheckResourceAttr("sendgrid_global_suppression.this", "emails.#", "2"),
+					testAccCheckSendgridGlobalSuppressed("a@example.org", true),
+					testAccCheckSendgridGlobalSuppressed("b@example.org", true),
+				),
+			},
+			{
+				PreConfig: func() {
+					c := testAccProvider.Meta().(*sendgrid.Client)
+					if _, err := c.AddGlobalSuppressions(context.Background(), []string{unmanaged}); err.Err != nil {
+						t.Fatal(err.Err)
+					}
+				},
+				Config: testAccCheckSendgridGlobalSuppressionConfigBasic("b@example.org", "c@example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_global_suppression.this", "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_global_suppression.this", "emails.*", "c@example.org"),
+					testAccCheckSendgridGlobalSuppressed("a@example.org", false),
+					testAccCheckSendgridGlobalSuppressed("c@example.org", true),
+					// Recipients unsubscribed outside of Terraform are left alone.
+					testAccCheckSendgridGlobalSuppressed(unmanaged, true),
+					testAccCheckSendgridSuppressionsRefreshEmpty("sendgrid_global_suppression.this"),
+					func(*terraform.State) error {
+						c := testAccProvider.Meta().(*sendgrid.Client)
+						_, err := c.DeleteGlobalSuppression(context.Background(), unmanaged)
+
+						return err.Err
+					},
+				),
+			},
+			{
+				ResourceName:      "sendgrid_global_suppression.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckSendgridSuppressionsRefreshEmpty checks that refreshing the suppressions of n once all the
+// managed emails were removed outside of Terraform doesn't start managing the other suppressed recipients,
+// which the next apply would resubscribe.
+func testAccCheckSendgridSuppressionsRefreshEmpty(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		r := testAccProvider.ResourcesMap[rs.Type]
+		d := r.Data(nil)
+		d.SetId(rs.Primary.ID)
+
+		if diags := r.ReadContext(context.Background(), d, testAccProvider.Meta()); diags.HasError() {
+			return fmt.Errorf("refreshing %s: %v", n, diags)
+		}
+
+		if emails := d.Get("emails").(*schema.Set); emails.Len() > 0 {
+			return fmt.Errorf("expected %s to manage no emails, got %v", n, emails.List())
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridGlobalSuppressed(email string, suppressed bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		got, err := c.ReadGlobalSuppression(context.Background(), email)
+		if err.Err != nil {
+			return err.Err
+		}
+
+		if got != suppressed {
+			return fmt.Errorf("expected %s to be suppressed: %t, got %t", email, suppressed, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridGlobalSuppressionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_global_suppression" {
+			continue
+		}
+
+		for key, email := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "emails.") || key == "emails.#" {
+				continue
+			}
+
+			if err := testAccCheckSendgridGlobalSuppressed(email, false)(s); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridGlobalSuppressionConfigBasic(emails ...string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_global_suppression" "this" {
+  emails = %s
+}`, formatResourceList(emails))
+}
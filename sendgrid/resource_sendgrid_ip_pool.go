@@ -0,0 +1,146 @@
+/*
+Provide a resource to manage an IP pool.
+
+**Note** The member IPs of a pool can either be listed in `ips`, or be managed with
+`sendgrid_ip_pool_membership` resources, but not both.
+Example Usage
+```hcl
+
+	data "sendgrid_ips" "all" {}
+
+	resource "sendgrid_ip_pool" "transactional" {
+		name = "transactional"
+		ips  = [data.sendgrid_ips.all.ips[0].ip]
+	}
+
+```
+Import
+An IP pool can be imported by name, e.g.
+```sh
+$ terraform import sendgrid_ip_pool.transactional <pool-name>
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridIPPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPPoolCreate,
+		ReadContext:   resourceSendgridIPPoolRead,
+		UpdateContext: resourceSendgridIPPoolUpdate,
+		DeleteContext: resourceSendgridIPPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the IP pool, max length: 64.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, ipPoolNameLength),
+			},
+			"ips": {
+				Type:        schema.TypeSet,
+				Description: "The IPs belonging to the pool.",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+		},
+	}
+}
+
+func resourceSendgridIPPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	pool, err := c.CreateIPPool(ctx, d.Get("name").(string))
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(pool.Name)
+
+	for _, ip := range stringSetToSlice(d.Get("ips").(*schema.Set)) {
+		if err := c.AddIPToPool(ctx, pool.Name, ip); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	return resourceSendgridIPPoolRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	pool, err := c.ReadIPPool(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	ips := make([]string, 0, len(pool.IPs))
+	for _, member := range pool.IPs {
+		ips = append(ips, member.IP)
+	}
+
+	//nolint:errcheck
+	d.Set("name", pool.Name)
+	//nolint:errcheck
+	d.Set("ips", ips)
+
+	return nil
+}
+
+func resourceSendgridIPPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if d.HasChange("name") {
+		pool, err := c.UpdateIPPool(ctx, d.Id(), d.Get("name").(string))
+		if err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+
+		d.SetId(pool.Name)
+	}
+
+	if d.HasChange("ips") {
+		o, n := d.GetChange("ips")
+		oldIPs := o.(*schema.Set)
+		newIPs := n.(*schema.Set)
+
+		for _, ip := range stringSetToSlice(oldIPs.Difference(newIPs)) {
+			if _, err := c.RemoveIPFromPool(ctx, d.Id(), ip); err.Err != nil {
+				return diagFromErr(err.Err)
+			}
+		}
+
+		for _, ip := range stringSetToSlice(newIPs.Difference(oldIPs)) {
+			if err := c.AddIPToPool(ctx, d.Id(), ip); err.Err != nil {
+				return diagFromErr(err.Err)
+			}
+		}
+	}
+
+	return resourceSendgridIPPoolRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if _, err := c.DeleteIPPool(ctx, d.Id()); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
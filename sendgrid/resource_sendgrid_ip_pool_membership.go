@@ -0,0 +1,124 @@
+/*
+Provide a resource to add an IP to an IP pool.
+Example Usage
+```hcl
+
+	resource "sendgrid_ip_pool" "marketing" {
+		name = "marketing"
+	}
+
+	resource "sendgrid_ip_pool_membership" "marketing" {
+		pool_name = sendgrid_ip_pool.marketing.name
+		ip        = "127.0.0.1"
+	}
+
+```
+Import
+An IP pool membership can be imported, e.g.
+```sh
+$ terraform import sendgrid_ip_pool_membership.marketing <pool-name>/<ip>
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridIPPoolMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPPoolMembershipCreate,
+		ReadContext:   resourceSendgridIPPoolMembershipRead,
+		DeleteContext: resourceSendgridIPPoolMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridIPPoolMembershipImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pool_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the IP pool.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "The IP to add to the pool.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+	}
+}
+
+func resourceSendgridIPPoolMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	poolName := d.Get("pool_name").(string)
+	ip := d.Get("ip").(string)
+
+	if err := c.AddIPToPool(ctx, poolName, ip); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(poolName + "/" + ip)
+
+	return resourceSendgridIPPoolMembershipRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	poolName := d.Get("pool_name").(string)
+	ip := d.Get("ip").(string)
+
+	pool, err := c.ReadIPPool(ctx, poolName)
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	for _, member := range pool.IPs {
+		if member.IP == ip {
+			return nil
+		}
+	}
+
+	return diagFromReadErr(d, fmt.Errorf("%w: IP %s in pool %s", sendgrid.ErrNotFound, ip, poolName))
+}
+
+func resourceSendgridIPPoolMembershipDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if _, err := c.RemoveIPFromPool(ctx, d.Get("pool_name").(string), d.Get("ip").(string)); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
+
+func resourceSendgridIPPoolMembershipImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	// Pool names may contain slashes, IPs can't.
+	i := strings.LastIndex(d.Id(), "/")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, ErrInvalidIPPoolMembershipImportFormat
+	}
+
+	//nolint:errcheck
+	d.Set("pool_name", d.Id()[:i])
+	//nolint:errcheck
+	d.Set("ip", d.Id()[i+1:])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,149 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridIPPoolBasic(t *testing.T) {
+	name := "terraform-pool-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridIPPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridIPPoolConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.this", "name", name),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.this", "ips.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_ip_pool.this", "ips.0", "data.sendgrid_ips.all", "ips.0.ip"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridIPPoolConfigBasic(name + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.this", "id", name+"-renamed"),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.this", "ips.#", "1"),
+				),
+			},
+			{
+				ResourceName: "sendgrid_ip_pool.this",
+				ImportState:  true,
+				ImportStateCheck: testAccCheckSendgridImportedAttributes("sendgrid_ip_pool", map[string]string{
+					"name":  name + "-renamed",
+					"ips.#": "1",
+				}),
+			},
+		},
+	})
+}
+
+func TestAccSendgridIPPoolMembershipBasic(t *testing.T) {
+	name := "terraform-pool-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridIPPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridIPPoolMembershipConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_ip_pool_membership.this", "ip", "data.sendgrid_ips.all", "ips.0.ip"),
+				),
+			},
+			{
+				ResourceName: "sendgrid_ip_pool_membership.this",
+				ImportState:  true,
+				ImportStateCheck: testAccCheckSendgridImportedAttributes("sendgrid_ip_pool_membership", map[string]string{
+					"pool_name": name,
+				}),
+			},
+			{
+				// The pool now lists the IP added by the membership.
+				Config: testAccCheckSendgridIPPoolMembershipConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.this", "ips.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.sendgrid_ips.all", "ips.0.pools.*", "sendgrid_ip_pool.this", "name"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckSendgridImportedAttributes checks the attributes of the imported resource of the given type.
+// ImportStateVerify can't be used with configurations reading data sources, since they are part of the imported state.
+func testAccCheckSendgridImportedAttributes(resourceType string, attributes map[string]string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		for _, s := range states {
+			if s.Ephemeral.Type != resourceType {
+				continue
+			}
+
+			for key, value := range attributes {
+				if s.Attributes[key] != value {
+					return fmt.Errorf("imported %s: %s is %q, expected %q", resourceType, key, s.Attributes[key], value)
+				}
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("no %s was imported", resourceType)
+	}
+}
+
+func testAccCheckSendgridIPPoolDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_ip_pool" {
+			continue
+		}
+
+		if _, err := c.DeleteIPPool(context.Background(), rs.Primary.ID); err.Err != nil {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridIPPoolConfigBasic(name string) string {
+	return fmt.Sprintf(`
+data "sendgrid_ips" "all" {}
+
+resource "sendgrid_ip_pool" "this" {
+  name = %q
+  ips  = [data.sendgrid_ips.all.ips[0].ip]
+}`, name)
+}
+
+func testAccCheckSendgridIPPoolMembershipConfigBasic(name string) string {
+	return fmt.Sprintf(`
+data "sendgrid_ips" "all" {
+  depends_on = [sendgrid_ip_pool_membership.this]
+}
+
+data "sendgrid_ips" "first" {}
+
+resource "sendgrid_ip_pool" "this" {
+  name = %q
+}
+
+resource "sendgrid_ip_pool_membership" "this" {
+  pool_name = sendgrid_ip_pool.this.name
+  ip        = data.sendgrid_ips.first.ips[0].ip
+}`, name)
+}
@@ -0,0 +1,94 @@
+/*
+Provide a resource to warm up a dedicated IP: Sendgrid gradually increases the volume of emails
+sent from it while it is being warmed up. The warmup stops when the resource is destroyed.
+Example Usage
+```hcl
+
+	data "sendgrid_ips" "all" {}
+
+	resource "sendgrid_ip_warmup" "dedicated" {
+		ip = data.sendgrid_ips.all.ips[0].ip
+	}
+
+```
+Import
+An IP warmup can be imported by IP, e.g.
+```sh
+$ terraform import sendgrid_ip_warmup.dedicated <ip>
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridIPWarmup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPWarmupCreate,
+		ReadContext:   resourceSendgridIPWarmupRead,
+		DeleteContext: resourceSendgridIPWarmupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "The IP to warm up.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"start_date": {
+				Type:        schema.TypeInt,
+				Description: "The date the warmup started, as a unix timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridIPWarmupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	warmup, err := c.StartIPWarmup(ctx, d.Get("ip").(string))
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(warmup.IP)
+
+	return resourceSendgridIPWarmupRead(ctx, d, m)
+}
+
+func resourceSendgridIPWarmupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	warmup, err := c.ReadIPWarmup(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("ip", warmup.IP)
+	//nolint:errcheck
+	d.Set("start_date", warmup.StartDate)
+
+	return nil
+}
+
+func resourceSendgridIPWarmupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if _, err := c.StopIPWarmup(ctx, d.Id()); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
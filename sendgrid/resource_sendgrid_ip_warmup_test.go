@@ -0,0 +1,96 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridIPWarmupBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridIPWarmupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridIPWarmupConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_ip_warmup.this", "ip", "data.sendgrid_ips.all", "ips.0.ip"),
+					resource.TestCheckResourceAttrSet("sendgrid_ip_warmup.this", "start_date"),
+				),
+			},
+			{
+				ResourceName: "sendgrid_ip_warmup.this",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					for _, s := range states {
+						if s.Ephemeral.Type == "sendgrid_ip_warmup" && s.Attributes["ip"] == s.ID && s.Attributes["start_date"] != "" {
+							return nil
+						}
+					}
+
+					return fmt.Errorf("no sendgrid_ip_warmup was imported")
+				},
+			},
+		},
+	})
+}
+
+func TestAccSendgridIPWarmupDisappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridIPWarmupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridIPWarmupConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridIPWarmupDisappears("sendgrid_ip_warmup.this"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridIPWarmupDisappears(resource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		if _, err := c.StopIPWarmup(context.Background(), s.RootModule().Resources[resource].Primary.ID); err.Err != nil {
+			return err.Err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridIPWarmupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_ip_warmup" {
+			continue
+		}
+
+		if _, err := c.ReadIPWarmup(context.Background(), rs.Primary.ID); err.Err == nil {
+			return fmt.Errorf("IP %s is still being warmed up", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridIPWarmupConfigBasic() string {
+	return `
+data "sendgrid_ips" "all" {}
+
+resource "sendgrid_ip_warmup" "this" {
+  ip = data.sendgrid_ips.all.ips[0].ip
+}`
+}
@@ -1,11 +1,18 @@
 /*
 Provide a resource to manage link branding.
+When `wait_for_validation` times out, the link branding is kept and a warning reports the invalid DNS records.
+Waiting for more than 20 minutes requires raising the `create` and `update` timeouts too.
 Example Usage
 ```hcl
 
 	resource "sendgrid_link_branding" "default" {
 		domain = "example.com"
 	    is_default = true
+
+	    wait_for_validation {
+	        timeout       = "15m"
+	        poll_interval = "1m"
+	    }
 	}
 
 ```
@@ -32,6 +39,7 @@ func resourceSendgridLinkBranding() *schema.Resource { //nolint:funlen
 		ReadContext:   resourceSendgridLinkBrandingRead,
 		UpdateContext: resourceSendgridLinkBrandingUpdate,
 		DeleteContext: resourceSendgridLinkBrandingDelete,
+		Timeouts:      waitForValidationTimeouts(),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -71,63 +79,57 @@ func resourceSendgridLinkBranding() *schema.Resource { //nolint:funlen
 				Type:        schema.TypeList,
 				Description: "The DNS records used to authenticate the sending domain.",
 				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"valid": {
-							Type:        schema.TypeBool,
-							Description: "Indicates if this is a valid CNAME.",
-							Computed:    true,
-						},
-						"type": {
-							Type:        schema.TypeString,
-							Description: "The type of DNS record.",
-							Computed:    true,
-						},
-						"host": {
-							Type:        schema.TypeString,
-							Description: "The domain that this CNAME is created for.",
-							Computed:    true,
-						},
-						"data": {
-							Type:        schema.TypeString,
-							Description: "The actual DNS record.",
-							Computed:    true,
-						},
-					},
-				},
+				Elem:        dnsRecordResource(),
 			},
+			"dns_records":         dnsRecordsSchema("domain_cname", "owner_cname"),
+			"wait_for_validation": waitForValidationSchema(),
+			"on_behalf_of":        onBehalfOfSchema(),
 		},
 	}
 }
 
+// validateLinkBranding returns the validateFunc of the link branding of d.
+func validateLinkBranding(c *sendgrid.Client, d *schema.ResourceData) validateFunc {
+	return func(ctx context.Context) (bool, map[string]sendgrid.ValidationResult, error) {
+		validation, err := c.ValidateLinkBranding(ctx, d.Id())
+		if err.Err != nil {
+			return false, nil, err.Err
+		}
+
+		return validation.Valid, validation.ValidationResults, nil
+	}
+}
+
 func resourceSendgridLinkBrandingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	domain := d.Get("domain").(string)
 	subdomain := d.Get("subdomain").(string)
 	isDefault := d.Get("is_default").(bool)
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateLinkBranding(ctx, domain, subdomain, isDefault)
-	})
-
-	link := apiKeyStruct.(*sendgrid.LinkBranding)
-
-	if err != nil {
-		return diag.FromErr(err)
+	link, err := c.CreateLinkBranding(ctx, domain, subdomain, isDefault)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	d.SetId(fmt.Sprint(link.ID))
 
-	return resourceSendgridLinkBrandingRead(ctx, d, m)
+	diags := waitForValidation(ctx, d, d.Timeout(schema.TimeoutCreate), "link branding", validateLinkBranding(c, d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceSendgridLinkBrandingRead(ctx, d, m)...)
 }
 
 func resourceSendgridLinkBrandingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	link, err := c.ReadLinkBranding(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return diagFromReadErr(d, err.Err)
 	}
 
 	//nolint:errcheck
@@ -164,42 +166,60 @@ func resourceSendgridLinkBrandingRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(er)
 	}
 
+	records := map[string]interface{}{}
+	setDNSRecord(records, "domain_cname",
+		link.DNS.DomainCNAME.Valid, link.DNS.DomainCNAME.Type, link.DNS.DomainCNAME.Host, link.DNS.DomainCNAME.Data)
+	setDNSRecord(records, "owner_cname",
+		link.DNS.OwnerCNAME.Valid, link.DNS.OwnerCNAME.Type, link.DNS.OwnerCNAME.Host, link.DNS.OwnerCNAME.Data)
+
+	if er := d.Set("dns_records", records); er != nil {
+		return diag.FromErr(er)
+	}
+
 	return nil
 }
 
 func resourceSendgridLinkBrandingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	isDefault := d.Get("is_default").(bool)
 
-	link, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateLinkBranding(ctx, d.Id(), isDefault)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	link, err := c.UpdateLinkBranding(ctx, d.Id(), isDefault)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
-	if !link.(*sendgrid.LinkBranding).Valid && d.Get("valid").(bool) {
-		if err := c.ValidateLinkBranding(ctx, d.Id()); err.Err != nil || err.StatusCode != 200 {
-			if err.Err != nil {
-				return diag.FromErr(err.Err)
-			}
+	if !link.Valid && d.Get("valid").(bool) {
+		validation, err := c.ValidateLinkBranding(ctx, d.Id())
+		if err.Err != nil {
+			return diagFromErr(err.Err)
+		}
 
-			return diag.Errorf("unable to validate link branding DNS configuration")
+		if !validation.Valid {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "unable to validate link branding DNS configuration",
+				Detail:   invalidRecords(validation.ValidationResults),
+			}}
 		}
 	}
 
-	return resourceSendgridLinkBrandingRead(ctx, d, m)
+	diags := waitForValidation(ctx, d, d.Timeout(schema.TimeoutUpdate), "link branding", validateLinkBranding(c, d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceSendgridLinkBrandingRead(ctx, d, m)...)
 }
 
 func resourceSendgridLinkBrandingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteLinkBranding(ctx, d.Id())
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.DeleteLinkBranding(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return nil
@@ -0,0 +1,60 @@
+/*
+Provide a resource to share a link branding of the parent account with a subuser.
+A subuser can only be associated with one link branding at a time.
+Example Usage
+```hcl
+
+	resource "sendgrid_link_branding" "default" {
+		domain = "example.com"
+	}
+
+	resource "sendgrid_subuser" "marketing" {
+		username = "marketing"
+		email    = "marketing@example.com"
+		password = "Passw0rd!"
+		ips      = ["127.0.0.1"]
+	}
+
+	resource "sendgrid_link_branding_subuser" "marketing" {
+		link_id  = sendgrid_link_branding.default.id
+		username = sendgrid_subuser.marketing.username
+	}
+
+```
+Import
+A link branding subuser association can be imported, e.g.
+```sh
+$ terraform import sendgrid_link_branding_subuser.marketing <link-id>/<username>
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridLinkBrandingSubuser() *schema.Resource {
+	return resourceSendgridSubuserAssociation(subuserAssociation{
+		object:    "link branding",
+		idKey:     "link_id",
+		associate: (*sendgrid.Client).AssociateLinkBrandingWithSubuser,
+		read: func(c *sendgrid.Client, ctx context.Context, username string) (string, sendgrid.RequestError) {
+			link, err := c.ReadSubuserLinkBranding(ctx, username)
+			if err.Err != nil {
+				return "", err
+			}
+
+			return fmt.Sprint(link.ID), err
+		},
+		disassociate: func(c *sendgrid.Client, ctx context.Context, username string) sendgrid.RequestError {
+			_, err := c.DisassociateSubuserLinkBranding(ctx, username)
+
+			return err
+		},
+		errImportFormat: ErrInvalidLinkBrandingSubuserImportFormat,
+	})
+}
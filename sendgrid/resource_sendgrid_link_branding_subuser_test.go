@@ -0,0 +1,73 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridLinkBrandingSubuserBasic(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridLinkBrandingSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridLinkBrandingSubuserConfigBasic(domain, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_link_branding_subuser.this", "link_id", "sendgrid_link_branding.this", "id"),
+					resource.TestCheckResourceAttr("sendgrid_link_branding_subuser.this", "username", username),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_link_branding_subuser.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridLinkBrandingSubuserDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_link_branding_subuser" {
+			continue
+		}
+
+		username := rs.Primary.ID[strings.Index(rs.Primary.ID, "/")+1:]
+
+		_, err := c.ReadSubuserLinkBranding(context.Background(), username)
+		if err.Err == nil {
+			return fmt.Errorf("link branding still associated with subuser %s", username)
+		}
+
+		if !errors.Is(err.Err, sendgrid.ErrNotFound) {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridLinkBrandingSubuserConfigBasic(domain, username string) string {
+	return testAccCheckSendgridLinkBrandingConfigBasic(domain, false, "") +
+		testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+			[]string{"127.0.0.1"}) + `
+resource "sendgrid_link_branding_subuser" "this" {
+  link_id = sendgrid_link_branding.this.id
+  username = sendgrid_subuser.this.username
+}`
+}
@@ -0,0 +1,83 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridLinkBrandingBasic(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridLinkBrandingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridLinkBrandingConfigBasic(domain, false, `
+  wait_for_validation {
+    poll_interval = "0s"
+  }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected wait_for_validation.0.poll_interval to be a positive duration`),
+			},
+			{
+				Config: testAccCheckSendgridLinkBrandingConfigBasic(domain, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_link_branding.this", "domain", domain),
+					resource.TestCheckResourceAttr("sendgrid_link_branding.this", "valid", "false"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_link_branding.this", "dns_records.domain_cname.host", "links."+domain),
+					resource.TestCheckResourceAttr("sendgrid_link_branding.this", "dns_records.owner_cname.type", "cname"),
+				),
+			},
+			{
+				// The DNS records don't exist, so validating the link branding fails.
+				Config: testAccCheckSendgridLinkBrandingConfigBasic(domain, true, ""),
+				ExpectError: regexp.MustCompile(
+					`(?s)unable to validate link branding DNS configuration.*domain_cname: Expected cname record`),
+			},
+			{
+				Config: testAccCheckSendgridLinkBrandingConfigBasic(domain, false, `
+  wait_for_validation {
+    timeout       = "1s"
+    poll_interval = "100ms"
+  }`),
+				Check: resource.TestCheckResourceAttr("sendgrid_link_branding.this", "valid", "false"),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridLinkBrandingDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_link_branding" {
+			continue
+		}
+
+		if _, err := c.DeleteLinkBranding(context.Background(), rs.Primary.ID); err.Err != nil {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridLinkBrandingConfigBasic(domain string, valid bool, waitForValidation string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_link_branding" "this" {
+  domain    = %q
+  subdomain = "links"
+  valid     = %t
+%s
+}`, domain, valid, waitForValidation)
+}
@@ -0,0 +1,61 @@
+/*
+Provide a resource to manage the address whitelist mail setting.
+When enabled, the emails and domains of the list are never suppressed: emails are delivered to them
+even after a bounce, a block or a spam report.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_address_whitelist" "default" {
+		enabled = true
+		list    = ["example.com", "postmaster@example.org"]
+	}
+
+```
+Import
+The mail setting can be imported by `address_whitelist` for the parent account, or by the username of a subuser,
+e.g.
+```sh
+$ terraform import sendgrid_mail_settings_address_whitelist.default address_whitelist
+```
+*/
+package sendgrid
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMailSettingsAddressWhitelist() *schema.Resource {
+	return resourceSendgridAccountSetting(accountSetting{
+		api:  mailSettingsAPI,
+		name: "address_whitelist",
+		schema: map[string]*schema.Schema{
+			"list": {
+				Type:        schema.TypeSet,
+				Description: "The emails or domains which are never suppressed.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+		new: func() interface{} { return &sendgrid.MailSettingAddressWhitelist{} },
+		expand: func(d *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.MailSettingAddressWhitelist{
+				Enabled: enabled,
+				List:    stringSetToSlice(d.Get("list").(*schema.Set)),
+			}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			whitelist := setting.(*sendgrid.MailSettingAddressWhitelist)
+			//nolint:errcheck
+			d.Set("enabled", whitelist.Enabled)
+			//nolint:errcheck
+			d.Set("list", whitelist.List)
+		},
+		defaults: func() interface{} { return &sendgrid.MailSettingAddressWhitelist{List: []string{}} },
+	})
+}
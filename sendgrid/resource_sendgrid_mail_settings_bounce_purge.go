@@ -0,0 +1,78 @@
+/*
+Provide a resource to manage the bounce purge mail setting.
+When enabled, the soft and hard bounces are removed from the bounce list after a number of days,
+so that emails are delivered to these recipients again.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_bounce_purge" "default" {
+		enabled      = true
+		soft_bounces = 7
+		hard_bounces = 90
+	}
+
+```
+Import
+The mail setting can be imported by `bounce_purge` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_bounce_purge.default bounce_purge
+```
+*/
+package sendgrid
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+// maxBouncePurgeDays is the largest number of days bounces can be kept for.
+const maxBouncePurgeDays = 3650
+
+func resourceSendgridMailSettingsBouncePurge() *schema.Resource {
+	return resourceSendgridAccountSetting(accountSetting{
+		api:  mailSettingsAPI,
+		name: "bounce_purge",
+		schema: map[string]*schema.Schema{
+			"soft_bounces": {
+				Type:         schema.TypeInt,
+				Description:  "The number of days after which the soft bounces are purged. Kept forever when unset.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxBouncePurgeDays),
+			},
+			"hard_bounces": {
+				Type:         schema.TypeInt,
+				Description:  "The number of days after which the hard bounces are purged. Kept forever when unset.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxBouncePurgeDays),
+			},
+		},
+		new: func() interface{} { return &sendgrid.MailSettingBouncePurge{} },
+		expand: func(d *schema.ResourceData, enabled bool) interface{} {
+			setting := &sendgrid.MailSettingBouncePurge{Enabled: enabled}
+
+			if days, ok := d.GetOk("soft_bounces"); ok {
+				softBounces := days.(int)
+				setting.SoftBounces = &softBounces
+			}
+
+			if days, ok := d.GetOk("hard_bounces"); ok {
+				hardBounces := days.(int)
+				setting.HardBounces = &hardBounces
+			}
+
+			return setting
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			bouncePurge := setting.(*sendgrid.MailSettingBouncePurge)
+			//nolint:errcheck
+			d.Set("enabled", bouncePurge.Enabled)
+			//nolint:errcheck
+			d.Set("soft_bounces", bouncePurge.SoftBounces)
+			//nolint:errcheck
+			d.Set("hard_bounces", bouncePurge.HardBounces)
+		},
+		defaults: func() interface{} { return &sendgrid.MailSettingBouncePurge{} },
+	})
+}
@@ -0,0 +1,26 @@
+/*
+Provide a resource to manage the bypass list management mail setting.
+When enabled, emails are delivered to every recipient, bypassing the bounce, spam report, unsubscribe and
+global unsubscribe lists. Reserve it for emails which must reach the recipient, like password resets.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_bypass_list_management" "default" {
+		enabled = true
+	}
+
+```
+Import
+The mail setting can be imported by `bypass_list_management` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_bypass_list_management.default bypass_list_management
+```
+*/
+package sendgrid
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceSendgridMailSettingsBypassListManagement() *schema.Resource {
+	return resourceSendgridAccountSetting(mailSettingEnabled("bypass_list_management"))
+}
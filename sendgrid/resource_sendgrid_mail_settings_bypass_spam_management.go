@@ -0,0 +1,25 @@
+/*
+Provide a resource to manage the bypass spam management mail setting.
+When enabled, emails are delivered to the recipients who reported previous emails as spam.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_bypass_spam_management" "default" {
+		enabled = true
+	}
+
+```
+Import
+The mail setting can be imported by `bypass_spam_management` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_bypass_spam_management.default bypass_spam_management
+```
+*/
+package sendgrid
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceSendgridMailSettingsBypassSpamManagement() *schema.Resource {
+	return resourceSendgridAccountSetting(mailSettingEnabled("bypass_spam_management"))
+}
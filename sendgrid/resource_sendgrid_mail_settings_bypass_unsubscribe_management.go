@@ -0,0 +1,25 @@
+/*
+Provide a resource to manage the bypass unsubscribe management mail setting.
+When enabled, emails are delivered to the recipients who globally unsubscribed.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_bypass_unsubscribe_management" "default" {
+		enabled = true
+	}
+
+```
+Import
+The mail setting can be imported by `bypass_unsubscribe_management` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_bypass_unsubscribe_management.default bypass_unsubscribe_management
+```
+*/
+package sendgrid
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceSendgridMailSettingsBypassUnsubscribeManagement() *schema.Resource {
+	return resourceSendgridAccountSetting(mailSettingEnabled("bypass_unsubscribe_management"))
+}
@@ -0,0 +1,63 @@
+/*
+Provide a resource to manage the footer mail setting.
+When enabled, the footer is appended to the HTML and plain text content of every email.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_footer" "default" {
+		enabled       = true
+		html_content  = "<p>Example Inc, 1 Main Street</p>"
+		plain_content = "Example Inc, 1 Main Street"
+	}
+
+```
+Import
+The mail setting can be imported by `footer` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_footer.default footer
+```
+*/
+package sendgrid
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMailSettingsFooter() *schema.Resource {
+	return resourceSendgridAccountSetting(accountSetting{
+		api:  mailSettingsAPI,
+		name: "footer",
+		schema: map[string]*schema.Schema{
+			"html_content": {
+				Type:        schema.TypeString,
+				Description: "The footer appended to the HTML content of the emails.",
+				Optional:    true,
+			},
+			"plain_content": {
+				Type:        schema.TypeString,
+				Description: "The footer appended to the plain text content of the emails.",
+				Optional:    true,
+			},
+		},
+		new: func() interface{} { return &sendgrid.MailSettingFooter{} },
+		expand: func(d *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.MailSettingFooter{
+				Enabled:      enabled,
+				HTMLContent:  d.Get("html_content").(string),
+				PlainContent: d.Get("plain_content").(string),
+			}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			footer := setting.(*sendgrid.MailSettingFooter)
+			//nolint:errcheck
+			d.Set("enabled", footer.Enabled)
+			//nolint:errcheck
+			d.Set("html_content", footer.HTMLContent)
+			//nolint:errcheck
+			d.Set("plain_content", footer.PlainContent)
+		},
+		defaults: func() interface{} { return &sendgrid.MailSettingFooter{} },
+	})
+}
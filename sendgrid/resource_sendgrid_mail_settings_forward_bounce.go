@@ -0,0 +1,27 @@
+/*
+Provide a resource to manage the forward bounce mail setting.
+When enabled, the bounce messages are forwarded to an email address.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_forward_bounce" "default" {
+		enabled = true
+		email   = "postmaster@example.com"
+	}
+
+```
+Import
+The mail setting can be imported by `forward_bounce` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_forward_bounce.default forward_bounce
+```
+*/
+package sendgrid
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceSendgridMailSettingsForwardBounce() *schema.Resource {
+	return resourceSendgridAccountSetting(mailSettingForward("forward_bounce",
+		"The email address the bounce messages are forwarded to."))
+}
@@ -0,0 +1,27 @@
+/*
+Provide a resource to manage the forward spam mail setting.
+When enabled, the spam reports are forwarded to an email address.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_forward_spam" "default" {
+		enabled = true
+		email   = "postmaster@example.com"
+	}
+
+```
+Import
+The mail setting can be imported by `forward_spam` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_forward_spam.default forward_spam
+```
+*/
+package sendgrid
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceSendgridMailSettingsForwardSpam() *schema.Resource {
+	return resourceSendgridAccountSetting(mailSettingForward("forward_spam",
+		"The email address the spam reports are forwarded to."))
+}
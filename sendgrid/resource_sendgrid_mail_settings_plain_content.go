@@ -0,0 +1,25 @@
+/*
+Provide a resource to manage the plain content mail setting.
+When enabled, emails are converted to plain text, dropping their HTML content.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_plain_content" "default" {
+		enabled = true
+	}
+
+```
+Import
+The mail setting can be imported by `plain_content` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_plain_content.default plain_content
+```
+*/
+package sendgrid
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceSendgridMailSettingsPlainContent() *schema.Resource {
+	return resourceSendgridAccountSetting(mailSettingEnabled("plain_content"))
+}
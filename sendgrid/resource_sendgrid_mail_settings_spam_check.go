@@ -0,0 +1,72 @@
+/*
+Provide a resource to manage the spam check mail setting.
+When enabled, the emails scored above the maximum score are dropped, and can be posted to a URL for inspection.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_spam_check" "default" {
+		enabled   = true
+		max_score = 7
+		url       = "https://example.com/spam"
+	}
+
+```
+Import
+The mail setting can be imported by `spam_check` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_mail_settings_spam_check.default spam_check
+```
+*/
+package sendgrid
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+const (
+	defaultSpamCheckMaxScore = 5
+	maxSpamCheckMaxScore     = 10
+)
+
+func resourceSendgridMailSettingsSpamCheck() *schema.Resource {
+	return resourceSendgridAccountSetting(accountSetting{
+		api:  mailSettingsAPI,
+		name: "spam_check",
+		schema: map[string]*schema.Schema{
+			"max_score": {
+				Type:         schema.TypeInt,
+				Description:  "The spam score above which emails are dropped, from 1, the strictest, to 10.",
+				Optional:     true,
+				Default:      defaultSpamCheckMaxScore,
+				ValidateFunc: validation.IntBetween(1, maxSpamCheckMaxScore),
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Description:  "The URL the dropped emails are posted to.",
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+		new: func() interface{} { return &sendgrid.MailSettingSpamCheck{} },
+		expand: func(d *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.MailSettingSpamCheck{
+				Enabled:  enabled,
+				MaxScore: d.Get("max_score").(int),
+				URL:      d.Get("url").(string),
+			}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			spamCheck := setting.(*sendgrid.MailSettingSpamCheck)
+			//nolint:errcheck
+			d.Set("enabled", spamCheck.Enabled)
+			//nolint:errcheck
+			d.Set("max_score", spamCheck.MaxScore)
+			//nolint:errcheck
+			d.Set("url", spamCheck.URL)
+		},
+		defaults: func() interface{} { return &sendgrid.MailSettingSpamCheck{MaxScore: defaultSpamCheckMaxScore} },
+	})
+}
@@ -0,0 +1,218 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridMailSettingsFooter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridMailSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMailSettingsFooterConfig(true, "<p>Example Inc</p>"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.this", "id", "footer"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.this", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.this", "html_content", "<p>Example Inc</p>"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.this", "plain_content", "Example Inc"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridMailSettingsFooterConfig(false, "<p>Example Corp</p>"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.this", "enabled", "false"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.this", "html_content", "<p>Example Corp</p>"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_footer.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridMailSettingsBypassListManagement(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridMailSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sendgrid_mail_settings_bypass_list_management" "this" {
+  enabled = true
+}`,
+				Check: resource.TestCheckResourceAttr(
+					"sendgrid_mail_settings_bypass_list_management.this", "enabled", "true"),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_bypass_list_management.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridMailSettingsForwardBounce(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridMailSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sendgrid_mail_settings_forward_bounce" "this" {
+  enabled = true
+  email   = "postmaster@example.org"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_forward_bounce.this", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_mail_settings_forward_bounce.this", "email", "postmaster@example.org"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridMailSettingsBouncePurge(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridMailSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sendgrid_mail_settings_bounce_purge" "this" {
+  enabled      = true
+  soft_bounces = 7
+  hard_bounces = 90
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.this", "soft_bounces", "7"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.this", "hard_bounces", "90"),
+				),
+			},
+			{
+				// The soft bounces are kept forever once unset.
+				Config: `
+resource "sendgrid_mail_settings_bounce_purge" "this" {
+  enabled      = true
+  hard_bounces = 30
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.this", "soft_bounces", "0"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.this", "hard_bounces", "30"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_bounce_purge.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridMailSettingsAddressWhitelist(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridMailSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sendgrid_mail_settings_address_whitelist" "this" {
+  enabled = true
+  list    = ["example.org", "postmaster@example.com"]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_address_whitelist.this", "list.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"sendgrid_mail_settings_address_whitelist.this", "list.*", "example.org"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_address_whitelist.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridMailSettingsSpamCheck(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridMailSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sendgrid_mail_settings_spam_check" "this" {
+  enabled = true
+  url     = "https://example.org/spam"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_spam_check.this", "max_score", "5"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_spam_check.this", "url", "https://example.org/spam"),
+				),
+			},
+			{
+				Config: `
+resource "sendgrid_mail_settings_spam_check" "this" {
+  enabled   = true
+  max_score = 8
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_spam_check.this", "max_score", "8"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_spam_check.this", "url", ""),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckSendgridMailSettingsDestroy checks that deleting mail settings resources disabled the settings.
+func testAccCheckSendgridMailSettingsDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		name := strings.TrimPrefix(rs.Type, "sendgrid_mail_settings_")
+		if name == rs.Type {
+			continue
+		}
+
+		var setting sendgrid.MailSettingEnabled
+		if err := c.ReadMailSetting(context.Background(), name, &setting); err.Err != nil {
+			return err.Err
+		}
+
+		if setting.Enabled {
+			return fmt.Errorf("mail setting %s still enabled", name)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridMailSettingsFooterConfig(enabled bool, htmlContent string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_mail_settings_footer" "this" {
+  enabled       = %t
+  html_content  = %q
+  plain_content = "Example Inc"
+}`, enabled, htmlContent)
+}
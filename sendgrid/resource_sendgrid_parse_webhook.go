@@ -66,26 +66,23 @@ func resourceSendgridParseWebhook() *schema.Resource {
 					"When this parameter is set to \"true\", SendGrid will send a JSON payload of the content of your email.",
 				Optional: true,
 			},
+			"on_behalf_of": onBehalfOfSchema(),
 		},
 	}
 }
 
 func resourceSendgridParseWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	hostname := d.Get("hostname").(string)
 	url := d.Get("url").(string)
 	spamCheck := d.Get("spam_check").(bool)
 	sendRaw := d.Get("send_raw").(bool)
 
-	parseWebhookStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateParseWebhook(ctx, hostname, url, spamCheck, sendRaw)
-	})
-
-	webhook := parseWebhookStruct.(*sendgrid.ParseWebhook)
-
-	if err != nil {
-		return diag.FromErr(err)
+	webhook, err := c.CreateParseWebhook(ctx, hostname, url, spamCheck, sendRaw)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	d.SetId(webhook.Hostname)
@@ -95,10 +92,11 @@ func resourceSendgridParseWebhookCreate(ctx context.Context, d *schema.ResourceD
 
 func resourceSendgridParseWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	webhook, err := c.ReadParseWebhook(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return diagFromReadErr(d, err.Err)
 	}
 
 	//nolint:errcheck
@@ -115,15 +113,13 @@ func resourceSendgridParseWebhookRead(ctx context.Context, d *schema.ResourceDat
 
 func resourceSendgridParseWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	spamCheck := d.Get("spam_check").(bool)
 	sendRaw := d.Get("send_raw").(bool)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return nil, c.UpdateParseWebhook(ctx, d.Id(), spamCheck, sendRaw)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	if err := c.UpdateParseWebhook(ctx, d.Id(), spamCheck, sendRaw); err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return resourceSendgridParseWebhookRead(ctx, d, m)
@@ -131,12 +127,11 @@ func resourceSendgridParseWebhookUpdate(ctx context.Context, d *schema.ResourceD
 
 func resourceSendgridParseWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteParseWebhook(ctx, d.Id())
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.DeleteParseWebhook(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return nil
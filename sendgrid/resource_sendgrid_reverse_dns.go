@@ -0,0 +1,238 @@
+/*
+Provide a resource to manage a reverse DNS, formerly known as IP whitelabel.
+Example Usage
+```hcl
+
+	resource "sendgrid_reverse_dns" "default" {
+		ip        = "10.10.10.10"
+		domain    = "example.com"
+		subdomain = "email"
+	}
+
+	resource "aws_route53_record" "reverse_dns" {
+		zone_id = aws_route53_zone.example.zone_id
+		name    = sendgrid_reverse_dns.default.a_record[0].host
+		type    = "A"
+		ttl     = 300
+		records = [sendgrid_reverse_dns.default.a_record[0].data]
+	}
+
+```
+Import
+A reverse DNS can be imported, e.g.
+```sh
+$ terraform import sendgrid_reverse_dns.default <reverse-dns-id>
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridReverseDNS() *schema.Resource { //nolint:funlen
+	return &schema.Resource{
+		CreateContext: resourceSendgridReverseDNSCreate,
+		ReadContext:   resourceSendgridReverseDNSRead,
+		UpdateContext: resourceSendgridReverseDNSUpdate,
+		DeleteContext: resourceSendgridReverseDNSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "The IP to set up reverse DNS for.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The root, or sending, domain that will be used to send messages from the IP.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Description: "The subdomain that will be used to send emails from the IP.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"rdns": {
+				Type:        schema.TypeString,
+				Description: "The reverse DNS record of the IP, it points to the IP.",
+				Computed:    true,
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Description: "The users allowed to send emails from the IP.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The username of the user.",
+							Computed:    true,
+						},
+						"user_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the user.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"legacy": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the reverse DNS was created with the legacy whitelabel API.",
+				Computed:    true,
+			},
+			"valid": {
+				Type: schema.TypeBool,
+				Description: "Indicates if this is a valid reverse DNS or not. " +
+					"Set to `true` to attempt validation on first update.",
+				Optional: true,
+				Computed: true,
+			},
+			"last_validation_attempt_at": {
+				Type:        schema.TypeInt,
+				Description: "The date of the last validation attempt, as a unix timestamp.",
+				Computed:    true,
+			},
+			"a_record": {
+				Type:        schema.TypeList,
+				Description: "The A record to add to the DNS of the domain.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"valid": {
+							Type:        schema.TypeBool,
+							Description: "Indicates if the A record is valid.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The type of DNS record.",
+							Computed:    true,
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Description: "The domain that this A record is created for.",
+							Computed:    true,
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Description: "The IP the A record points to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSendgridReverseDNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	ip := d.Get("ip").(string)
+	domain := d.Get("domain").(string)
+	subdomain := d.Get("subdomain").(string)
+
+	rdns, err := c.CreateReverseDNS(ctx, ip, domain, subdomain)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(fmt.Sprint(rdns.ID))
+
+	return resourceSendgridReverseDNSRead(ctx, d, m)
+}
+
+func resourceSendgridReverseDNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	rdns, err := c.ReadReverseDNS(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("ip", rdns.IP)
+	//nolint:errcheck
+	d.Set("domain", rdns.Domain)
+	//nolint:errcheck
+	d.Set("subdomain", rdns.Subdomain)
+	//nolint:errcheck
+	d.Set("rdns", rdns.RDNS)
+	//nolint:errcheck
+	d.Set("legacy", rdns.Legacy)
+	//nolint:errcheck
+	d.Set("valid", rdns.Valid)
+	//nolint:errcheck
+	d.Set("last_validation_attempt_at", rdns.LastValidationAttemptAt)
+
+	users := make([]interface{}, 0, len(rdns.Users))
+	for _, user := range rdns.Users {
+		users = append(users, map[string]interface{}{
+			"username": user.Username,
+			"user_id":  user.UserID,
+		})
+	}
+
+	if er := d.Set("users", users); er != nil {
+		return diagFromErr(er)
+	}
+
+	aRecord := []interface{}{
+		map[string]interface{}{
+			"valid": rdns.ARecord.Valid,
+			"type":  rdns.ARecord.Type,
+			"host":  rdns.ARecord.Host,
+			"data":  rdns.ARecord.Data,
+		},
+	}
+
+	if er := d.Set("a_record", aRecord); er != nil {
+		return diagFromErr(er)
+	}
+
+	return nil
+}
+
+func resourceSendgridReverseDNSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if d.HasChange("valid") && d.Get("valid").(bool) {
+		result, err := c.ValidateReverseDNS(ctx, d.Id())
+		if err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+
+		if !result.Valid {
+			return diag.Errorf("unable to validate reverse DNS configuration: %s",
+				result.ValidationResults.ARecord.Reason)
+		}
+	}
+
+	return resourceSendgridReverseDNSRead(ctx, d, m)
+}
+
+func resourceSendgridReverseDNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if _, err := c.DeleteReverseDNS(ctx, d.Id()); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridReverseDNSBasic(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridReverseDNSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridReverseDNSConfigBasic(domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.this", "rdns", "email."+domain),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.this", "valid", "false"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.this", "a_record.0.type", "a"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.this", "a_record.0.host", "email."+domain),
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_reverse_dns.this", "a_record.0.data", "data.sendgrid_ips.all", "ips.0.ip"),
+				),
+			},
+			{
+				ResourceName: "sendgrid_reverse_dns.this",
+				ImportState:  true,
+				ImportStateCheck: testAccCheckSendgridImportedAttributes("sendgrid_reverse_dns", map[string]string{
+					"domain":          domain,
+					"subdomain":       "email",
+					"a_record.0.host": "email." + domain,
+				}),
+			},
+			{
+				// The A record doesn't exist, so validating the reverse DNS fails.
+				Config:      testAccCheckSendgridReverseDNSConfigBasic(domain, true),
+				ExpectError: regexp.MustCompile("unable to validate reverse DNS configuration"),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridReverseDNSDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_reverse_dns" {
+			continue
+		}
+
+		if _, err := c.DeleteReverseDNS(context.Background(), rs.Primary.ID); err.Err != nil {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridReverseDNSConfigBasic(domain string, valid bool) string {
+	return fmt.Sprintf(`
+data "sendgrid_ips" "all" {}
+
+resource "sendgrid_reverse_dns" "this" {
+  ip        = data.sendgrid_ips.all.ips[0].ip
+  domain    = %q
+  subdomain = "email"
+  valid     = %t
+}`, domain, valid)
+}
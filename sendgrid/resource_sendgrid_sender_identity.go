@@ -0,0 +1,225 @@
+/*
+Provide a resource to manage a verified sender identity.
+
+**Note** Sendgrid sends a verification email to `from_email`, the sender can only be used once the link
+it contains was clicked. Change `resend_verification` to send that email again.
+Example Usage
+```hcl
+
+	resource "sendgrid_sender_identity" "support" {
+		nickname   = "Support"
+		from_email = "support@example.com"
+		from_name  = "Example Support"
+		reply_to   = "support@example.com"
+		address    = "1234 Fake St"
+		city       = "San Francisco"
+		state      = "CA"
+		zip        = "94105"
+		country    = "USA"
+	}
+
+```
+Import
+A sender identity can be imported, e.g.
+```sh
+$ terraform import sendgrid_sender_identity.support <sender-id>
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSenderIdentity() *schema.Resource { //nolint:funlen
+	return &schema.Resource{
+		CreateContext: resourceSendgridSenderIdentityCreate,
+		ReadContext:   resourceSendgridSenderIdentityRead,
+		UpdateContext: resourceSendgridSenderIdentityUpdate,
+		DeleteContext: resourceSendgridSenderIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nickname": {
+				Type:         schema.TypeString,
+				Description:  "A nickname for the sender identity, not used for sending emails.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, maxStringLength),
+			},
+			"from_email": {
+				Type:        schema.TypeString,
+				Description: "The email address from which your recipients will receive emails.",
+				Required:    true,
+			},
+			"from_name": {
+				Type:        schema.TypeString,
+				Description: "The name appended to the from email field.",
+				Optional:    true,
+			},
+			"reply_to": {
+				Type:        schema.TypeString,
+				Description: "The email address to which your recipients will reply.",
+				Required:    true,
+			},
+			"reply_to_name": {
+				Type:        schema.TypeString,
+				Description: "The name appended to the reply to email field.",
+				Optional:    true,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "The physical address of the sender.",
+				Required:    true,
+			},
+			"address2": {
+				Type:        schema.TypeString,
+				Description: "Additional sender address information.",
+				Optional:    true,
+			},
+			"city": {
+				Type:        schema.TypeString,
+				Description: "The city of the sender.",
+				Required:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "The state of the sender.",
+				Optional:    true,
+			},
+			"zip": {
+				Type:        schema.TypeString,
+				Description: "The zip code of the sender.",
+				Optional:    true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Description: "The country of the sender.",
+				Required:    true,
+			},
+			"verified": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the sender identity was verified.",
+				Computed:    true,
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the sender identity is locked, i.e. can't be edited or deleted.",
+				Computed:    true,
+			},
+			"resend_verification": {
+				Type: schema.TypeString,
+				Description: "An arbitrary value, changing it sends the verification email again " +
+					"if the sender identity isn't verified yet.",
+				Optional: true,
+			},
+			"on_behalf_of": onBehalfOfSchema(),
+		},
+	}
+}
+
+func senderIdentityFromResourceData(d *schema.ResourceData) *sendgrid.SenderIdentity {
+	return &sendgrid.SenderIdentity{
+		Nickname:    d.Get("nickname").(string),
+		FromEmail:   d.Get("from_email").(string),
+		FromName:    d.Get("from_name").(string),
+		ReplyTo:     d.Get("reply_to").(string),
+		ReplyToName: d.Get("reply_to_name").(string),
+		Address:     d.Get("address").(string),
+		Address2:    d.Get("address2").(string),
+		City:        d.Get("city").(string),
+		State:       d.Get("state").(string),
+		Zip:         d.Get("zip").(string),
+		Country:     d.Get("country").(string),
+	}
+}
+
+func resourceSendgridSenderIdentityCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	sender, err := c.CreateSenderIdentity(ctx, senderIdentityFromResourceData(d))
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(fmt.Sprint(sender.ID))
+
+	return resourceSendgridSenderIdentityRead(ctx, d, m)
+}
+
+func resourceSendgridSenderIdentityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	sender, err := c.ReadSenderIdentity(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("nickname", sender.Nickname)
+	//nolint:errcheck
+	d.Set("from_email", sender.FromEmail)
+	//nolint:errcheck
+	d.Set("from_name", sender.FromName)
+	//nolint:errcheck
+	d.Set("reply_to", sender.ReplyTo)
+	//nolint:errcheck
+	d.Set("reply_to_name", sender.ReplyToName)
+	//nolint:errcheck
+	d.Set("address", sender.Address)
+	//nolint:errcheck
+	d.Set("address2", sender.Address2)
+	//nolint:errcheck
+	d.Set("city", sender.City)
+	//nolint:errcheck
+	d.Set("state", sender.State)
+	//nolint:errcheck
+	d.Set("zip", sender.Zip)
+	//nolint:errcheck
+	d.Set("country", sender.Country)
+	//nolint:errcheck
+	d.Set("verified", sender.Verified)
+	//nolint:errcheck
+	d.Set("locked", sender.Locked)
+
+	return nil
+}
+
+func resourceSendgridSenderIdentityUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	if d.HasChangeExcept("resend_verification") {
+		if _, err := c.UpdateSenderIdentity(ctx, d.Id(), senderIdentityFromResourceData(d)); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	if d.HasChange("resend_verification") && !d.Get("verified").(bool) {
+		if err := c.ResendSenderIdentityVerification(ctx, d.Id()); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	return resourceSendgridSenderIdentityRead(ctx, d, m)
+}
+
+func resourceSendgridSenderIdentityDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	if _, err := c.DeleteSenderIdentity(ctx, d.Id()); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
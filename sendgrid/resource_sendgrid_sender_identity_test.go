@@ -0,0 +1,74 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridSenderIdentityBasic(t *testing.T) {
+	nickname := "terraform-sender-" + acctest.RandString(10)
+	email := nickname + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSenderIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSenderIdentityConfigBasic(nickname, email, "San Francisco", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_sender_identity.this", "nickname", nickname),
+					resource.TestCheckResourceAttr("sendgrid_sender_identity.this", "verified", "false"),
+					resource.TestCheckResourceAttr("sendgrid_sender_identity.this", "locked", "false"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSenderIdentityConfigBasic(nickname, email, "Montreal", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_sender_identity.this", "city", "Montreal"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_sender_identity.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resend_verification"},
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSenderIdentityDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_sender_identity" {
+			continue
+		}
+
+		if _, err := c.DeleteSenderIdentity(context.Background(), rs.Primary.ID); err.Err != nil {
+			return err.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridSenderIdentityConfigBasic(nickname, email, city, resend string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_sender_identity" "this" {
+  nickname            = %q
+  from_email          = %q
+  reply_to            = %q
+  address             = "1234 Fake St"
+  city                = %q
+  country             = "USA"
+  resend_verification = %q
+}`, nickname, email, email, city, resend)
+}
@@ -59,25 +59,23 @@ func resourceSendgridSSOCertificate() *schema.Resource {
 				Description: "An ID that matches an existing SSO integration.",
 				Required:    true,
 			},
+			"on_behalf_of": onBehalfOfSchema(),
 		},
 	}
 }
 
 func resourceSendgridSSOCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	publicCertificate := d.Get("public_certificate").(string)
 	integrationID := d.Get("integration_id").(string)
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateSSOCertificate(ctx, publicCertificate, integrationID)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	certificate, err := c.CreateSSOCertificate(ctx, publicCertificate, integrationID)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
-	certificate := apiKeyStruct.(*sendgrid.SSOCertificate)
-
 	d.SetId(fmt.Sprint(certificate.ID))
 
 	return resourceSendgridSSOCertificateRead(ctx, d, m)
@@ -85,11 +83,12 @@ func resourceSendgridSSOCertificateCreate(ctx context.Context, d *schema.Resourc
 
 func resourceSendgridSSOCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	certificate, requestErr := c.ReadSSOCertificate(ctx, d.Id())
 
 	if requestErr.Err != nil {
-		return diag.FromErr(requestErr.Err)
+		return diagFromReadErr(d, requestErr.Err)
 	}
 
 	//nolint:errcheck
@@ -102,16 +101,15 @@ func resourceSendgridSSOCertificateRead(ctx context.Context, d *schema.ResourceD
 
 func resourceSendgridSSOCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	id := d.Id()
 	publicCertificate := d.Get("public_certificate").(string)
 	integrationID := d.Get("integration_id").(string)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateSSOCertificate(ctx, id, publicCertificate, integrationID)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.UpdateSSOCertificate(ctx, id, publicCertificate, integrationID)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return resourceSendgridSSOCertificateRead(ctx, d, m)
@@ -119,12 +117,11 @@ func resourceSendgridSSOCertificateUpdate(ctx context.Context, d *schema.Resourc
 
 func resourceSendgridSSOCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteSSOCertificate(ctx, fmt.Sprint(d.Id()))
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.DeleteSSOCertificate(ctx, fmt.Sprint(d.Id()))
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return nil
@@ -89,12 +89,14 @@ func resourceSendgridSSOIntegration() *schema.Resource {
 					This is the same URL as the Single Sign-On URL when using SendGrid.`,
 				Computed: true,
 			},
+			"on_behalf_of": onBehalfOfSchema(),
 		},
 	}
 }
 
 func resourceSendgridSSOIntegrationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	name := d.Get("name").(string)
 	enabled := d.Get("enabled").(bool)
@@ -102,15 +104,11 @@ func resourceSendgridSSOIntegrationCreate(ctx context.Context, d *schema.Resourc
 	signOutURL := d.Get("signout_url").(string)
 	entityID := d.Get("entity_id").(string)
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateSSOIntegration(ctx, name, enabled, signInURL, signOutURL, entityID)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	integration, err := c.CreateSSOIntegration(ctx, name, enabled, signInURL, signOutURL, entityID)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
-	integration := apiKeyStruct.(*sendgrid.SSOIntegration)
-
 	d.SetId(integration.ID)
 
 	return resourceSendgridSSOIntegrationRead(ctx, d, m)
@@ -118,11 +116,12 @@ func resourceSendgridSSOIntegrationCreate(ctx context.Context, d *schema.Resourc
 
 func resourceSendgridSSOIntegrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	integration, requestErr := c.ReadSSOIntegration(d.Id(), ctx)
 
 	if requestErr.Err != nil {
-		return diag.FromErr(requestErr.Err)
+		return diagFromReadErr(d, requestErr.Err)
 	}
 
 	//nolint:errcheck
@@ -147,6 +146,7 @@ func resourceSendgridSSOIntegrationRead(ctx context.Context, d *schema.ResourceD
 
 func resourceSendgridSSOIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	id := d.Id()
 	name := d.Get("name").(string)
@@ -155,11 +155,9 @@ func resourceSendgridSSOIntegrationUpdate(ctx context.Context, d *schema.Resourc
 	signOutURL := d.Get("signout_url").(string)
 	entityID := d.Get("entity_id").(string)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateSSOIntegration(ctx, id, name, enabled, signInURL, signOutURL, entityID)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.UpdateSSOIntegration(ctx, id, name, enabled, signInURL, signOutURL, entityID)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return resourceSendgridSSOIntegrationRead(ctx, d, m)
@@ -167,12 +165,11 @@ func resourceSendgridSSOIntegrationUpdate(ctx context.Context, d *schema.Resourc
 
 func resourceSendgridSSOIntegrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteSSOIntegration(ctx, d.Id())
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.DeleteSSOIntegration(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return nil
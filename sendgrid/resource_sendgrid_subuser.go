@@ -14,16 +14,20 @@ Example Usage
 
 ```
 Import
-A subuser can be imported, e.g.
+A subuser can be imported by its username, e.g.
 ```hcl
 $ terraform import sendgrid_subuser.subuser userName
 ```
+Its password can't be read back: the plan shows it as changed until it is set in the configuration.
+Since Sendgrid requires the current password to change it, applying that change only records it in the state.
 */
 package sendgrid
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
@@ -101,11 +105,9 @@ func resourceSendgridSubuserCreate(ctx context.Context, d *schema.ResourceData,
 		ips = append(ips, ip.(string))
 	}
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateSubuser(ctx, username, email, password, ips)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.CreateSubuser(ctx, username, email, password, ips)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	d.SetId(username)
@@ -120,25 +122,46 @@ func resourceSendgridSubuserCreate(ctx context.Context, d *schema.ResourceData,
 func resourceSendgridSubuserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	// hack to clear any on behalf of set in create sub user
-	// to fix this properly I think we need to pass this down rather than setting global state
-	c.OnBehalfOf = ""
+	subUsers, requestErr := c.ReadSubUser(ctx, d.Id())
+	if requestErr.Err != nil {
+		return diagFromReadErr(d, requestErr.Err)
+	}
+
+	// The username filter of the API isn't an exact match, it can return other subusers.
+	var subUser *sendgrid.SubUser
+
+	for i := range subUsers {
+		if subUsers[i].UserName == d.Id() {
+			subUser = &subUsers[i]
+
+			break
+		}
+	}
 
-	subUser, requestErr := c.ReadSubUser(ctx, d.Id())
+	if subUser == nil {
+		return diagFromReadErr(d, fmt.Errorf("%w: subuser %s", sendgrid.ErrNotFound, d.Id()))
+	}
+
+	ips, requestErr := c.ReadSubuserIPs(ctx, d.Id())
 	if requestErr.Err != nil {
-		return diag.FromErr(requestErr.Err)
+		return diagFromErr(requestErr.Err)
 	}
 
-	if len(subUser) == 0 {
-		return diag.FromErr(subUserNotFound(d.Id()))
+	addresses := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, ip.IP)
 	}
 
 	//nolint:errcheck
-	d.Set("user_id", subUser[0].ID)
+	d.Set("username", subUser.UserName)
+	//nolint:errcheck
+	d.Set("user_id", subUser.ID)
 	//nolint:errcheck
-	d.Set("disabled", subUser[0].Disabled)
+	d.Set("disabled", subUser.Disabled)
 	//nolint:errcheck
-	d.Set("email", subUser[0].Email)
+	d.Set("email", subUser.Email)
+	//nolint:errcheck
+	d.Set("ips", addresses)
 
 	return nil
 }
@@ -146,9 +169,11 @@ func resourceSendgridSubuserRead(ctx context.Context, d *schema.ResourceData, m
 func resourceSendgridSubuserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
+	var diags diag.Diagnostics
+
 	if d.HasChange("disabled") {
 		if _, requestErr := c.UpdateSubuser(ctx, d.Id(), d.Get("disabled").(bool)); requestErr.Err != nil {
-			return diag.FromErr(requestErr.Err)
+			return diagFromErr(requestErr.Err)
 		}
 	}
 
@@ -161,35 +186,42 @@ func resourceSendgridSubuserUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if requestErr := c.UpdateSubuserIPs(ctx, d.Id(), ips); requestErr.Err != nil {
-			return diag.FromErr(requestErr.Err)
+			return diagFromErr(requestErr.Err)
 		}
 	}
 
-	if d.HasChange("password") {
+	// The password was just set when creating the subuser, and isn't known after importing it.
+	if d.HasChange("password") && !d.IsNewResource() {
 		oldPassword, newPassword := d.GetChange("password")
 		username := d.Get("username").(string)
 
-		if requestErr := c.UpdateSubuserPassword(
+		if oldPassword.(string) == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The password of subuser " + username + " wasn't changed",
+				Detail: "The current password of the subuser is unknown, e.g. since it was imported: " +
+					"the configured password is only recorded in the state.",
+				AttributePath: cty.GetAttrPath("password"),
+			})
+		} else if requestErr := c.UpdateSubuserPassword(
 			ctx,
 			username,
 			oldPassword.(string),
 			newPassword.(string),
 		); requestErr.Err != nil {
-			return diag.FromErr(requestErr.Err)
+			return diagFromErr(requestErr.Err)
 		}
 	}
 
-	return resourceSendgridSubuserRead(ctx, d, m)
+	return append(diags, resourceSendgridSubuserRead(ctx, d, m)...)
 }
 
 func resourceSendgridSubuserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteSubuser(ctx, d.Id())
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.DeleteSubuser(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return nil
@@ -0,0 +1,163 @@
+/*
+Provide a resource to manage the credit allocation of a subuser, i.e. the number of emails it can send.
+A subuser can send an unlimited number of emails by default, deleting the resource restores it.
+Example Usage
+```hcl
+
+	resource "sendgrid_subuser" "marketing" {
+		username = "marketing"
+		email    = "marketing@example.com"
+		password = "Passw0rd!"
+		ips      = ["127.0.0.1"]
+	}
+
+	resource "sendgrid_subuser_credits" "marketing" {
+		username        = sendgrid_subuser.marketing.username
+		type            = "recurring"
+		total           = 10000
+		reset_frequency = "monthly"
+	}
+
+```
+Import
+The credit allocation of a subuser can be imported by the username of the subuser, e.g.
+```sh
+$ terraform import sendgrid_subuser_credits.marketing marketing
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSubuserCredits() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSubuserCreditsUpdate,
+		ReadContext:   resourceSendgridSubuserCreditsRead,
+		UpdateContext: resourceSendgridSubuserCreditsUpdate,
+		DeleteContext: resourceSendgridSubuserCreditsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceSendgridSubuserCreditsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the subuser.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Type: schema.TypeString,
+				Description: "The type of the credit allocation: unlimited, recurring (`total` credits every " +
+					"`reset_frequency`) or nonrecurring (`total` credits once).",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"unlimited", "recurring", "nonrecurring"}, false),
+			},
+			"total": {
+				Type:         schema.TypeInt,
+				Description:  "The number of credits allocated, required unless the type is unlimited.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"reset_frequency": {
+				Type:         schema.TypeString,
+				Description:  "How often recurring credits are reset: daily, weekly or monthly.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"daily", "weekly", "monthly"}, false),
+			},
+			"remain": {
+				Type:        schema.TypeInt,
+				Description: "The number of credits remaining.",
+				Computed:    true,
+			},
+			"used": {
+				Type:        schema.TypeInt,
+				Description: "The number of credits used.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceSendgridSubuserCreditsCustomizeDiff checks at plan time the attributes required by the type of the credits.
+func resourceSendgridSubuserCreditsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	creditsType := d.Get("type").(string)
+
+	if creditsType != "unlimited" && config.GetAttr("total").IsNull() {
+		return ErrSubuserCreditsTotalRequired
+	}
+
+	if creditsType == "recurring" && config.GetAttr("reset_frequency").IsNull() {
+		return ErrSubuserCreditsResetFrequencyRequired
+	}
+
+	return nil
+}
+
+func resourceSendgridSubuserCreditsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	username := d.Get("username").(string)
+
+	if _, err := c.UpdateSubuserCredits(ctx, username, sendgrid.SubuserCredits{
+		Type:           d.Get("type").(string),
+		Total:          d.Get("total").(int),
+		ResetFrequency: d.Get("reset_frequency").(string),
+	}); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(username)
+
+	return resourceSendgridSubuserCreditsRead(ctx, d, m)
+}
+
+func resourceSendgridSubuserCreditsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	credits, err := c.ReadSubuserCredits(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("username", d.Id())
+	//nolint:errcheck
+	d.Set("type", credits.Type)
+	//nolint:errcheck
+	d.Set("total", credits.Total)
+	//nolint:errcheck
+	d.Set("reset_frequency", credits.ResetFrequency)
+	//nolint:errcheck
+	d.Set("remain", credits.Remain)
+	//nolint:errcheck
+	d.Set("used", credits.Used)
+
+	return nil
+}
+
+func resourceSendgridSubuserCreditsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	_, err := c.UpdateSubuserCredits(ctx, d.Id(), sendgrid.SubuserCredits{Type: "unlimited"})
+	if err.Err != nil && !errors.Is(err.Err, sendgrid.ErrNotFound) {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridSubuserCredits(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserCreditsConfig(username, `
+  type            = "recurring"
+  total           = 1000
+  reset_frequency = "monthly"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "id", username),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "type", "recurring"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "total", "1000"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "reset_frequency", "monthly"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "remain", "1000"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "used", "0"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSubuserCreditsConfig(username, `
+  type  = "nonrecurring"
+  total = 500`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "type", "nonrecurring"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "total", "500"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "reset_frequency", ""),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_subuser_credits.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// A total of 0 cuts the subuser off.
+				Config: testAccCheckSendgridSubuserCreditsConfig(username, `
+  type  = "nonrecurring"
+  total = 0`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "total", "0"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_credits.this", "remain", "0"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSubuserCreditsConfig(username, `
+  type  = "recurring"
+  total = 1000`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("reset_frequency is required for recurring credits"),
+			},
+			{
+				Config: testAccCheckSendgridSubuserCreditsConfig(username, `
+  type = "nonrecurring"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("total is required unless the type of the credits is unlimited"),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSubuserCreditsConfig(username, credits string) string {
+	return testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+		[]string{"127.0.0.1"}) + fmt.Sprintf(`
+resource "sendgrid_subuser_credits" "this" {
+  username = sendgrid_subuser.this.username
+%s
+}`, credits)
+}
@@ -0,0 +1,127 @@
+/*
+Provide a resource to monitor a subuser: a sample of the emails it sends is copied to an address,
+to keep an eye on their content.
+Example Usage
+```hcl
+
+	resource "sendgrid_subuser" "marketing" {
+		username = "marketing"
+		email    = "marketing@example.com"
+		password = "Passw0rd!"
+		ips      = ["127.0.0.1"]
+	}
+
+	resource "sendgrid_subuser_monitor" "marketing" {
+		username  = sendgrid_subuser.marketing.username
+		email     = "compliance@example.com"
+		frequency = 500
+	}
+
+```
+Import
+The monitor of a subuser can be imported by the username of the subuser, e.g.
+```sh
+$ terraform import sendgrid_subuser_monitor.marketing marketing
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSubuserMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSubuserMonitorCreate,
+		ReadContext:   resourceSendgridSubuserMonitorRead,
+		UpdateContext: resourceSendgridSubuserMonitorUpdate,
+		DeleteContext: resourceSendgridSubuserMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the subuser.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address the sample emails are copied to.",
+				Required:    true,
+			},
+			"frequency": {
+				Type:         schema.TypeInt,
+				Description:  "The number of emails sent by the subuser between two sample emails.",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceSendgridSubuserMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	username := d.Get("username").(string)
+
+	if _, err := c.CreateSubuserMonitor(ctx, username, sendgrid.SubuserMonitor{
+		Email:     d.Get("email").(string),
+		Frequency: d.Get("frequency").(int),
+	}); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(username)
+
+	return resourceSendgridSubuserMonitorRead(ctx, d, m)
+}
+
+func resourceSendgridSubuserMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	monitor, err := c.ReadSubuserMonitor(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("username", d.Id())
+	//nolint:errcheck
+	d.Set("email", monitor.Email)
+	//nolint:errcheck
+	d.Set("frequency", monitor.Frequency)
+
+	return nil
+}
+
+func resourceSendgridSubuserMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if _, err := c.UpdateSubuserMonitor(ctx, d.Id(), sendgrid.SubuserMonitor{
+		Email:     d.Get("email").(string),
+		Frequency: d.Get("frequency").(int),
+	}); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return resourceSendgridSubuserMonitorRead(ctx, d, m)
+}
+
+func resourceSendgridSubuserMonitorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if err := c.DeleteSubuserMonitor(ctx, d.Id()); err.Err != nil && !errors.Is(err.Err, sendgrid.ErrNotFound) {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
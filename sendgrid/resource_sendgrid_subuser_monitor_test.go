@@ -0,0 +1,106 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridSubuserMonitor(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserMonitorConfig(username, "compliance@example.org", 500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_monitor.this", "id", username),
+					resource.TestCheckResourceAttr("sendgrid_subuser_monitor.this", "email", "compliance@example.org"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_monitor.this", "frequency", "500"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSubuserMonitorConfig(username, "audit@example.org", 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_monitor.this", "email", "audit@example.org"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_monitor.this", "frequency", "100"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_subuser_monitor.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing the monitor stops monitoring the subuser.
+				Config: testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+					[]string{"127.0.0.1"}),
+				Check: testAccCheckSendgridSubuserMonitorDeleted(username),
+			},
+		},
+	})
+}
+
+func TestAccSendgridSubuserMonitorDeleteNotFound(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("the failure is injected in the fake Sendgrid API")
+	}
+
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserMonitorConfig(username, "compliance@example.org", 500),
+			},
+			{
+				// A monitor already removed outside of Terraform is deleted without error.
+				PreConfig: func() {
+					testAccServer.FailRequest(http.MethodDelete, "/subusers/{username}/monitor", 1, http.StatusNotFound)
+				},
+				Config: testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+					[]string{"127.0.0.1"}),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSubuserMonitorDeleted(username string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		_, err := c.ReadSubuserMonitor(context.Background(), username)
+		if err.Err == nil {
+			return fmt.Errorf("subuser %s still monitored", username)
+		}
+
+		if !errors.Is(err.Err, sendgrid.ErrNotFound) {
+			return err.Err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridSubuserMonitorConfig(username, email string, frequency int) string {
+	return testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+		[]string{"127.0.0.1"}) + fmt.Sprintf(`
+resource "sendgrid_subuser_monitor" "this" {
+  username  = sendgrid_subuser.this.username
+  email     = %q
+  frequency = %d
+}`, email, frequency)
+}
@@ -3,6 +3,7 @@ package sendgrid_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -32,6 +33,112 @@ func TestAccSendgridSubuserBasic(t *testing.T) {
 	})
 }
 
+func TestAccSendgridSubuserDuplicateUsername(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	password := acctest.RandString(10)
+	email := username + "@example.org"
+	ips := []string{"127.0.0.1"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserConfigBasic(username, password, email, ips) + `
+resource "sendgrid_subuser" "duplicate" {
+  username = sendgrid_subuser.this.username
+  password = sendgrid_subuser.this.password
+  email    = sendgrid_subuser.this.email
+  ips      = sendgrid_subuser.this.ips
+}`,
+				ExpectError: regexp.MustCompile("username exists"),
+			},
+		},
+	})
+}
+
+func TestAccSendgridSubuserUsernamePrefix(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	password := acctest.RandString(10)
+	ips := []string{"127.0.0.1"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The username filter of the API also returns the subuser created first,
+				// whose username starts with the one of this subuser.
+				Config: fmt.Sprintf(`
+resource "sendgrid_subuser" "longer" {
+  username = "%[1]s-longer"
+  password = %[2]q
+  email    = "%[1]s-longer@example.org"
+  ips      = %[3]s
+}
+
+resource "sendgrid_subuser" "this" {
+  username = %[1]q
+  password = %[2]q
+  email    = "%[1]s@example.org"
+  ips      = %[3]s
+
+  depends_on = [sendgrid_subuser.longer]
+}`, username, password, formatResourceList(ips)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.this", "username", username),
+					resource.TestCheckResourceAttr("sendgrid_subuser.this", "email", username+"@example.org"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.longer", "email", username+"-longer@example.org"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridSubuserImport(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	password := acctest.RandString(10)
+	email := username + "@example.org"
+	ips := []string{"127.0.0.1", "255.255.255.255"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserConfigBasic(username, password, email, ips),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.this", "ips.#", "2"),
+					resource.TestCheckResourceAttrSet("sendgrid_subuser.this", "user_id"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_subuser.this",
+				ImportState:       true,
+				ImportStateId:     username,
+				ImportStateVerify: true,
+				// The password is write-only.
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				// IPs assigned outside of Terraform are detected.
+				PreConfig: func() {
+					c := testAccProvider.Meta().(*sendgrid.Client)
+					if err := c.UpdateSubuserIPs(context.Background(), username, ips[:1]); err.Err != nil {
+						t.Fatal(err.Err)
+					}
+				},
+				Config:             testAccCheckSendgridSubuserConfigBasic(username, password, email, ips),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckSendgridSubuserDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*sendgrid.Client)
 
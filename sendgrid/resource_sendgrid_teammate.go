@@ -0,0 +1,442 @@
+/*
+Provide a resource to manage teammates.
+
+**Note** A teammate signing in with a password is invited by email: until the invitation is accepted
+it is `pending` and has no `username`. Changing the permissions of a pending teammate sends a new invitation.
+A teammate signing in through an SSO integration (`is_sso`) is created right away, with its email as username,
+and can be given access to subusers.
+Example Usage
+```hcl
+
+	resource "sendgrid_teammate" "developer" {
+		email  = "developer@example.com"
+		scopes = ["templates.read", "templates.create", "templates.update"]
+	}
+
+	resource "sendgrid_teammate" "support" {
+		email      = "support@example.com"
+		is_sso     = true
+		first_name = "Jane"
+		last_name  = "Doe"
+
+		has_restricted_subuser_access = true
+		subuser_access {
+			id              = sendgrid_subuser.marketing.user_id
+			permission_type = "restricted"
+			scopes          = ["stats.read"]
+		}
+	}
+
+```
+Import
+A teammate can be imported by username, or by email while its invitation is pending, e.g.
+```sh
+$ terraform import sendgrid_teammate.developer <username>
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTeammate() *schema.Resource { //nolint:funlen
+	return &schema.Resource{
+		CreateContext: resourceSendgridTeammateCreate,
+		ReadContext:   resourceSendgridTeammateRead,
+		UpdateContext: resourceSendgridTeammateUpdate,
+		DeleteContext: resourceSendgridTeammateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridTeammateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email of the teammate, the invitation is sent to it.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"is_admin": {
+				Type:        schema.TypeBool,
+				Description: "Should the teammate be an admin, with every scope?",
+				Optional:    true,
+			},
+			"scopes": {
+				Type:        schema.TypeSet,
+				Description: "The permissions of the teammate, ignored for admins.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"is_sso": {
+				Type:        schema.TypeBool,
+				Description: "Does the teammate sign in through an SSO integration rather than with a password?",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Description: "The first name of the teammate, required for and only configurable on SSO teammates.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Description: "The last name of the teammate, required for and only configurable on SSO teammates.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"has_restricted_subuser_access": {
+				Type: schema.TypeBool,
+				Description: "Should the SSO teammate only have access to the subusers listed in subuser_access, " +
+					"rather than to the parent account?",
+				Optional: true,
+			},
+			"subuser_access": {
+				Type:        schema.TypeSet,
+				Description: "The subusers an SSO teammate with restricted subuser access has access to.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The user ID of the subuser.",
+							Required:    true,
+						},
+						"permission_type": {
+							Type:         schema.TypeString,
+							Description:  "Either admin, for every scope on the subuser, or restricted to scopes.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"admin", "restricted"}, false),
+						},
+						"scopes": {
+							Type:        schema.TypeSet,
+							Description: "The permissions of the teammate on the subuser, when restricted.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the teammate, empty until the invitation is accepted.",
+				Computed:    true,
+			},
+			"user_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the teammate: admin, owner or teammate.",
+				Computed:    true,
+			},
+			"pending": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the teammate hasn't accepted its invitation yet.",
+				Computed:    true,
+			},
+			"on_behalf_of": onBehalfOfSchema(),
+		},
+	}
+}
+
+func stringSetToSlice(s *schema.Set) []string {
+	values := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		values = append(values, v.(string))
+	}
+
+	return values
+}
+
+func ssoTeammateFromResourceData(d *schema.ResourceData) *sendgrid.Teammate {
+	access := make([]sendgrid.TeammateSubuserAccess, 0)
+
+	for _, v := range d.Get("subuser_access").(*schema.Set).List() {
+		a := v.(map[string]interface{})
+		access = append(access, sendgrid.TeammateSubuserAccess{
+			ID:             int32(a["id"].(int)),
+			PermissionType: a["permission_type"].(string),
+			Scopes:         stringSetToSlice(a["scopes"].(*schema.Set)),
+		})
+	}
+
+	return &sendgrid.Teammate{
+		Email:                      d.Get("email").(string),
+		FirstName:                  d.Get("first_name").(string),
+		LastName:                   d.Get("last_name").(string),
+		IsAdmin:                    d.Get("is_admin").(bool),
+		Scopes:                     stringSetToSlice(d.Get("scopes").(*schema.Set)),
+		HasRestrictedSubuserAccess: d.Get("has_restricted_subuser_access").(bool),
+		SubuserAccess:              access,
+	}
+}
+
+func validateTeammate(d *schema.ResourceData) diag.Diagnostics {
+	if d.Get("is_sso").(bool) {
+		if d.Get("first_name").(string) == "" || d.Get("last_name").(string) == "" {
+			return diag.Errorf("first_name and last_name are required for SSO teammates")
+		}
+
+		return nil
+	}
+
+	if d.HasChanges("first_name", "last_name") {
+		return diag.Errorf("first_name and last_name can only be set on SSO teammates")
+	}
+
+	if d.Get("has_restricted_subuser_access").(bool) || d.Get("subuser_access").(*schema.Set).Len() > 0 {
+		return diag.Errorf("subuser access can only be given to SSO teammates")
+	}
+
+	return nil
+}
+
+func resourceSendgridTeammateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	if diags := validateTeammate(d); diags.HasError() {
+		return diags
+	}
+
+	email := d.Get("email").(string)
+
+	if d.Get("is_sso").(bool) {
+		if _, err := c.CreateSSOTeammate(ctx, ssoTeammateFromResourceData(d)); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	} else {
+		isAdmin := d.Get("is_admin").(bool)
+		scopes := stringSetToSlice(d.Get("scopes").(*schema.Set))
+
+		if _, err := c.CreateTeammate(ctx, email, isAdmin, scopes); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	d.SetId(email)
+
+	return resourceSendgridTeammateRead(ctx, d, m)
+}
+
+// findPendingTeammate returns the invitation sent to email, or nil when there is none.
+func findPendingTeammate(ctx context.Context, c *sendgrid.Client, email string) (*sendgrid.PendingTeammate, error) {
+	pending, err := c.ReadPendingTeammates(ctx)
+	if err.Err != nil {
+		return nil, err.Err
+	}
+
+	for i := range pending {
+		if pending[i].Email == email {
+			return &pending[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findTeammateUsername returns the username of the teammate with the given email.
+func findTeammateUsername(ctx context.Context, c *sendgrid.Client, email string) (string, error) {
+	teammates, err := c.ReadTeammates(ctx)
+	if err.Err != nil {
+		return "", err.Err
+	}
+
+	for _, teammate := range teammates {
+		if teammate.Email == email {
+			return teammate.Username, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: teammate %s", sendgrid.ErrNotFound, email)
+}
+
+func resourceSendgridTeammateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	username := d.Get("username").(string)
+
+	if username == "" {
+		invite, err := findPendingTeammate(ctx, c, d.Id())
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		if invite != nil {
+			//nolint:errcheck
+			d.Set("email", invite.Email)
+			//nolint:errcheck
+			d.Set("is_admin", invite.IsAdmin)
+			//nolint:errcheck
+			d.Set("pending", true)
+
+			if !invite.IsAdmin {
+				//nolint:errcheck
+				d.Set("scopes", invite.Scopes)
+			}
+
+			return nil
+		}
+
+		// The invitation was accepted since the teammate was last read.
+		if username, err = findTeammateUsername(ctx, c, d.Id()); err != nil {
+			return diagFromReadErr(d, err)
+		}
+	}
+
+	teammate, err := c.ReadTeammate(ctx, username)
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("email", teammate.Email)
+	//nolint:errcheck
+	d.Set("username", teammate.Username)
+	//nolint:errcheck
+	d.Set("first_name", teammate.FirstName)
+	//nolint:errcheck
+	d.Set("last_name", teammate.LastName)
+	//nolint:errcheck
+	d.Set("user_type", teammate.UserType)
+	//nolint:errcheck
+	d.Set("is_admin", teammate.IsAdmin)
+	//nolint:errcheck
+	d.Set("is_sso", teammate.IsSSO)
+	//nolint:errcheck
+	d.Set("pending", false)
+
+	if !teammate.IsAdmin {
+		//nolint:errcheck
+		d.Set("scopes", teammate.Scopes)
+	}
+
+	if !teammate.IsSSO {
+		return nil
+	}
+
+	access, err := c.ReadTeammateSubuserAccess(ctx, teammate.Username)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	subuserAccess := make([]map[string]interface{}, 0, len(access.SubuserAccess))
+	for _, a := range access.SubuserAccess {
+		subuserAccess = append(subuserAccess, map[string]interface{}{
+			"id":              int(a.ID),
+			"permission_type": a.PermissionType,
+			"scopes":          a.Scopes,
+		})
+	}
+
+	//nolint:errcheck
+	d.Set("has_restricted_subuser_access", access.HasRestrictedSubuserAccess)
+	//nolint:errcheck
+	d.Set("subuser_access", subuserAccess)
+
+	return nil
+}
+
+func resourceSendgridTeammateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	if diags := validateTeammate(d); diags.HasError() {
+		return diags
+	}
+
+	username := d.Get("username").(string)
+	isAdmin := d.Get("is_admin").(bool)
+	scopes := stringSetToSlice(d.Get("scopes").(*schema.Set))
+
+	switch {
+	case d.Get("is_sso").(bool):
+		if _, err := c.UpdateSSOTeammate(ctx, username, ssoTeammateFromResourceData(d)); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	case d.Get("pending").(bool):
+		// A pending invitation can't be edited, it is replaced by a new one.
+		if diags := deletePendingTeammate(ctx, c, d.Id()); diags.HasError() {
+			return diags
+		}
+
+		if _, err := c.CreateTeammate(ctx, d.Id(), isAdmin, scopes); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	default:
+		if _, err := c.UpdateTeammate(ctx, username, isAdmin, scopes); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	return resourceSendgridTeammateRead(ctx, d, m)
+}
+
+func deletePendingTeammate(ctx context.Context, c *sendgrid.Client, email string) diag.Diagnostics {
+	invite, err := findPendingTeammate(ctx, c, email)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if invite == nil {
+		return nil
+	}
+
+	if _, err := c.DeletePendingTeammate(ctx, invite.Token); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
+
+func resourceSendgridTeammateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	username := d.Get("username").(string)
+	if username == "" {
+		return deletePendingTeammate(ctx, c, d.Id())
+	}
+
+	if _, err := c.DeleteTeammate(ctx, username); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
+
+// resourceSendgridTeammateImport resolves the username being imported to the email identifying the teammate.
+// An ID which isn't the username of a teammate is kept as is, to import a pending invitation by email.
+func resourceSendgridTeammateImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) ([]*schema.ResourceData, error) {
+	c := m.(*sendgrid.Client)
+
+	teammate, err := c.ReadTeammate(ctx, d.Id())
+	if err.Err != nil {
+		if errors.Is(err.Err, sendgrid.ErrNotFound) {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		return nil, err.Err
+	}
+
+	//nolint:errcheck
+	d.Set("username", teammate.Username)
+	d.SetId(teammate.Email)
+
+	return []*schema.ResourceData{d}, nil
+}
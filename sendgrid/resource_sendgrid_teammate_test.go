@@ -0,0 +1,141 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridTeammatePending(t *testing.T) {
+	email := "terraform-teammate-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTeammateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTeammateConfigPending(email, "templates.read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.this", "pending", "true"),
+					resource.TestCheckNoResourceAttr("sendgrid_teammate.this", "username"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.this", "scopes.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridTeammateConfigPending(email, "templates.update"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.this", "pending", "true"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_teammate.this", "scopes.*", "templates.update"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_teammate.this",
+				ImportState:       true,
+				ImportStateId:     email,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridTeammateSSO(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	email := "terraform-teammate-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTeammateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTeammateConfigSSO(username, email, "Jane", "stats.read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.this", "pending", "false"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.this", "username", email),
+					resource.TestCheckResourceAttr("sendgrid_teammate.this", "user_type", "teammate"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.this", "subuser_access.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridTeammateConfigSSO(username, email, "John", "stats.global.read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.this", "first_name", "John"),
+					resource.TestCheckTypeSetElemNestedAttrs("sendgrid_teammate.this", "subuser_access.*", map[string]string{
+						"permission_type": "restricted",
+						"scopes.0":        "stats.global.read",
+					}),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_teammate.this",
+				ImportState:       true,
+				ImportStateId:     email,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridTeammateDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_teammate" {
+			continue
+		}
+
+		if username := rs.Primary.Attributes["username"]; username != "" {
+			if _, err := c.DeleteTeammate(context.Background(), username); err.Err != nil {
+				return err.Err
+			}
+
+			continue
+		}
+
+		pending, err := c.ReadPendingTeammates(context.Background())
+		if err.Err != nil {
+			return err.Err
+		}
+
+		for _, invite := range pending {
+			if invite.Email == rs.Primary.ID {
+				return fmt.Errorf("teammate invitation still exists: %s", invite.Email)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridTeammateConfigPending(email, scope string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_teammate" "this" {
+  email  = %q
+  scopes = [%q]
+}`, email, scope)
+}
+
+func testAccCheckSendgridTeammateConfigSSO(username, email, firstName, scope string) string {
+	return testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+		[]string{"127.0.0.1"}) + fmt.Sprintf(`
+resource "sendgrid_teammate" "this" {
+  email      = %q
+  is_sso     = true
+  first_name = %q
+  last_name  = "Doe"
+  scopes     = ["templates.read"]
+
+  has_restricted_subuser_access = true
+  subuser_access {
+    id              = sendgrid_subuser.this.user_id
+    permission_type = "restricted"
+    scopes          = [%q]
+  }
+}`, email, firstName, scope)
+}
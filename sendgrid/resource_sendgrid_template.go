@@ -56,19 +56,21 @@ func resourceSendgridTemplate() *schema.Resource {
 				Description: "The date and time of the last update of this template.",
 				Computed:    true,
 			},
+			"on_behalf_of": onBehalfOfSchema(),
 		},
 	}
 }
 
 func resourceSendgridTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	name := d.Get("name").(string)
 	generation := d.Get("generation").(string)
 
 	template, err := c.CreateTemplate(ctx, name, generation)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(template.ID)
@@ -79,14 +81,15 @@ func resourceSendgridTemplateCreate(ctx context.Context, d *schema.ResourceData,
 
 func resourceSendgridTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	template, err := c.ReadTemplate(ctx, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromReadErr(d, err)
 	}
 
 	if err = sendgridTemplateParse(template, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return nil
 }
@@ -108,11 +111,12 @@ func sendgridTemplateParse(template *sendgrid.Template, d *schema.ResourceData)
 
 func resourceSendgridTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	if d.HasChange("name") {
 		_, err := c.UpdateTemplate(ctx, d.Id(), d.Get("name").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -121,9 +125,10 @@ func resourceSendgridTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 
 func resourceSendgridTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	if _, err := c.DeleteTemplate(ctx, d.Id()); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return nil
 }
@@ -31,6 +31,39 @@ func TestAccSendgridTemplateBasic(t *testing.T) {
 	})
 }
 
+func TestAccSendgridTemplateDisappears(t *testing.T) {
+	name := "terraform-template-" + acctest.RandString(10)
+	generation := "dynamic"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTemplateConfigBasic(name, generation),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridTemplateExists("sendgrid_template.this", name),
+					testAccCheckSendgridTemplateDisappears("sendgrid_template.this"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridTemplateDisappears(resource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		if _, err := c.DeleteTemplate(context.Background(), s.RootModule().Resources[resource].Primary.ID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckSendgridTemplateDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*sendgrid.Client)
 
@@ -117,12 +117,14 @@ func resourceSendgridTemplateVersion() *schema.Resource { //nolint:funlen
 					"the mock json data that will be used for template preview and test sends.",
 				Optional: true,
 			},
+			"on_behalf_of": onBehalfOfSchema(),
 		},
 	}
 }
 
 func resourceSendgridTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	templateVersion, err := c.CreateTemplateVersion(ctx, sendgrid.TemplateVersion{
 		TemplateID:           d.Get("template_id").(string),
@@ -135,7 +137,7 @@ func resourceSendgridTemplateVersionCreate(ctx context.Context, d *schema.Resour
 		TestData:             d.Get("test_data").(string),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(templateVersion.ID)
@@ -146,10 +148,11 @@ func resourceSendgridTemplateVersionCreate(ctx context.Context, d *schema.Resour
 
 func resourceSendgridTemplateVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	templateVersion, err := c.ReadTemplateVersion(ctx, d.Get("template_id").(string), d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromReadErr(d, err)
 	}
 
 	if er := parseTemplateVersion(d, templateVersion); er != nil {
@@ -205,6 +208,7 @@ func resourceSendgridTemplateVersionUpdate(
 	m interface{},
 ) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	baseTemplateVersion := sendgrid.TemplateVersion{
 		ID:         d.Id(),
@@ -245,7 +249,7 @@ func resourceSendgridTemplateVersionUpdate(
 	}
 
 	if _, err := c.UpdateTemplateVersion(ctx, templateVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceSendgridTemplateVersionRead(ctx, d, m)
@@ -253,10 +257,11 @@ func resourceSendgridTemplateVersionUpdate(
 
 func resourceSendgridTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	_, err := c.DeleteTemplateVersion(ctx, d.Get("template_id").(string), d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -0,0 +1,55 @@
+/*
+Provide a resource to manage the click tracking setting.
+When enabled, the links of the emails are rewritten to track the clicks, through the domain of
+the default `sendgrid_link_branding` if any.
+Deleting the resource restores the default setting of Sendgrid, enabled.
+Example Usage
+```hcl
+
+	resource "sendgrid_tracking_settings_click" "default" {
+		enabled     = true
+		enable_text = false
+	}
+
+```
+Import
+The tracking setting can be imported by `click` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_tracking_settings_click.default click
+```
+*/
+package sendgrid
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTrackingSettingsClick() *schema.Resource {
+	return resourceSendgridAccountSetting(accountSetting{
+		api:  trackingSettingsAPI,
+		name: "click",
+		schema: map[string]*schema.Schema{
+			"enable_text": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the links of the plain text content of the emails are tracked too.",
+				Optional:    true,
+			},
+		},
+		new: func() interface{} { return &sendgrid.TrackingSettingClick{} },
+		expand: func(d *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.TrackingSettingClick{
+				Enabled:    enabled,
+				EnableText: d.Get("enable_text").(bool),
+			}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			click := setting.(*sendgrid.TrackingSettingClick)
+			//nolint:errcheck
+			d.Set("enabled", click.Enabled)
+			//nolint:errcheck
+			d.Set("enable_text", click.EnableText)
+		},
+		defaults: func() interface{} { return &sendgrid.TrackingSettingClick{Enabled: true} },
+	})
+}
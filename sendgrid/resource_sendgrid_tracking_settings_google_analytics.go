@@ -0,0 +1,90 @@
+/*
+Provide a resource to manage the Google Analytics tracking setting.
+When enabled, the UTM parameters are added to the links of the emails, so that the visits they bring
+are attributed in Google Analytics.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_tracking_settings_google_analytics" "default" {
+		enabled      = true
+		utm_source   = "sendgrid"
+		utm_medium   = "email"
+		utm_campaign = "newsletter"
+	}
+
+```
+Import
+The tracking setting can be imported by `google_analytics` for the parent account, or by the username of a subuser,
+e.g.
+```sh
+$ terraform import sendgrid_tracking_settings_google_analytics.default google_analytics
+```
+*/
+package sendgrid
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTrackingSettingsGoogleAnalytics() *schema.Resource {
+	return resourceSendgridAccountSetting(accountSetting{
+		api:  trackingSettingsAPI,
+		name: "google_analytics",
+		schema: map[string]*schema.Schema{
+			"utm_source": {
+				Type:        schema.TypeString,
+				Description: "The default utm_source, the referrer of the visits, e.g. sendgrid.",
+				Optional:    true,
+			},
+			"utm_medium": {
+				Type:        schema.TypeString,
+				Description: "The default utm_medium, the marketing medium of the visits, e.g. email.",
+				Optional:    true,
+			},
+			"utm_term": {
+				Type:        schema.TypeString,
+				Description: "The default utm_term, the paid keywords of the visits.",
+				Optional:    true,
+			},
+			"utm_content": {
+				Type:        schema.TypeString,
+				Description: "The default utm_content, telling apart the links of a same email.",
+				Optional:    true,
+			},
+			"utm_campaign": {
+				Type:        schema.TypeString,
+				Description: "The default utm_campaign, the campaign of the visits.",
+				Optional:    true,
+			},
+		},
+		new: func() interface{} { return &sendgrid.TrackingSettingGoogleAnalytics{} },
+		expand: func(d *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.TrackingSettingGoogleAnalytics{
+				Enabled:     enabled,
+				UTMSource:   d.Get("utm_source").(string),
+				UTMMedium:   d.Get("utm_medium").(string),
+				UTMTerm:     d.Get("utm_term").(string),
+				UTMContent:  d.Get("utm_content").(string),
+				UTMCampaign: d.Get("utm_campaign").(string),
+			}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			googleAnalytics := setting.(*sendgrid.TrackingSettingGoogleAnalytics)
+			//nolint:errcheck
+			d.Set("enabled", googleAnalytics.Enabled)
+			//nolint:errcheck
+			d.Set("utm_source", googleAnalytics.UTMSource)
+			//nolint:errcheck
+			d.Set("utm_medium", googleAnalytics.UTMMedium)
+			//nolint:errcheck
+			d.Set("utm_term", googleAnalytics.UTMTerm)
+			//nolint:errcheck
+			d.Set("utm_content", googleAnalytics.UTMContent)
+			//nolint:errcheck
+			d.Set("utm_campaign", googleAnalytics.UTMCampaign)
+		},
+		defaults: func() interface{} { return &sendgrid.TrackingSettingGoogleAnalytics{} },
+	})
+}
@@ -0,0 +1,40 @@
+/*
+Provide a resource to manage the open tracking setting.
+When enabled, an invisible image is added to the emails to track when they are opened.
+Deleting the resource restores the default setting of Sendgrid, enabled.
+Example Usage
+```hcl
+
+	resource "sendgrid_tracking_settings_open" "default" {
+		enabled = false
+	}
+
+```
+Import
+The tracking setting can be imported by `open` for the parent account, or by the username of a subuser, e.g.
+```sh
+$ terraform import sendgrid_tracking_settings_open.default open
+```
+*/
+package sendgrid
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTrackingSettingsOpen() *schema.Resource {
+	return resourceSendgridAccountSetting(accountSetting{
+		api:  trackingSettingsAPI,
+		name: "open",
+		new:  func() interface{} { return &sendgrid.TrackingSettingOpen{} },
+		expand: func(_ *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.TrackingSettingOpen{Enabled: enabled}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			//nolint:errcheck
+			d.Set("enabled", setting.(*sendgrid.TrackingSettingOpen).Enabled)
+		},
+		defaults: func() interface{} { return &sendgrid.TrackingSettingOpen{Enabled: true} },
+	})
+}
@@ -0,0 +1,96 @@
+/*
+Provide a resource to manage the subscription tracking setting.
+When enabled, unsubscribe content is added to the emails, at the substitution tag if the email contains it,
+or at their end otherwise.
+Deleting the resource restores the default setting of Sendgrid.
+Example Usage
+```hcl
+
+	resource "sendgrid_tracking_settings_subscription" "default" {
+		enabled       = true
+		html_content  = "<p>To stop receiving these emails, <% click here %>.</p>"
+		plain_content = "To stop receiving these emails, go to <% %>."
+		landing       = "<p>You have been unsubscribed.</p>"
+		replace       = "[unsubscribe]"
+	}
+
+```
+Import
+The tracking setting can be imported by `subscription` for the parent account, or by the username of a subuser,
+e.g.
+```sh
+$ terraform import sendgrid_tracking_settings_subscription.default subscription
+```
+*/
+package sendgrid
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTrackingSettingsSubscription() *schema.Resource {
+	return resourceSendgridAccountSetting(accountSetting{
+		api:  trackingSettingsAPI,
+		name: "subscription",
+		schema: map[string]*schema.Schema{
+			"html_content": {
+				Type: schema.TypeString,
+				Description: "The unsubscribe content added to the HTML content of the emails. " +
+					"`<% %>` is replaced by the unsubscribe link, `<% text %>` by a link with that text.",
+				Optional: true,
+			},
+			"plain_content": {
+				Type: schema.TypeString,
+				Description: "The unsubscribe content added to the plain text content of the emails. " +
+					"`<% %>` is replaced by the unsubscribe URL.",
+				Optional: true,
+			},
+			"landing": {
+				Type:        schema.TypeString,
+				Description: "The HTML content of the landing page the recipients see after unsubscribing.",
+				Optional:    true,
+			},
+			"replace": {
+				Type: schema.TypeString,
+				Description: "The substitution tag, e.g. [unsubscribe], replaced by the unsubscribe content " +
+					"wherever the emails contain it.",
+				Optional: true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Description:  "The URL of a custom landing page the recipients are redirected to after unsubscribing.",
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+		new: func() interface{} { return &sendgrid.TrackingSettingSubscription{} },
+		expand: func(d *schema.ResourceData, enabled bool) interface{} {
+			return &sendgrid.TrackingSettingSubscription{
+				Enabled:      enabled,
+				HTMLContent:  d.Get("html_content").(string),
+				PlainContent: d.Get("plain_content").(string),
+				Landing:      d.Get("landing").(string),
+				Replace:      d.Get("replace").(string),
+				URL:          d.Get("url").(string),
+			}
+		},
+		flatten: func(d *schema.ResourceData, setting interface{}) {
+			subscription := setting.(*sendgrid.TrackingSettingSubscription)
+			//nolint:errcheck
+			d.Set("enabled", subscription.Enabled)
+			//nolint:errcheck
+			d.Set("html_content", subscription.HTMLContent)
+			//nolint:errcheck
+			d.Set("plain_content", subscription.PlainContent)
+			//nolint:errcheck
+			d.Set("landing", subscription.Landing)
+			//nolint:errcheck
+			d.Set("replace", subscription.Replace)
+			//nolint:errcheck
+			d.Set("url", subscription.URL)
+		},
+		defaults: func() interface{} { return &sendgrid.TrackingSettingSubscription{} },
+	})
+}
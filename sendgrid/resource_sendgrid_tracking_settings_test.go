@@ -0,0 +1,216 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridTrackingSettingsClick(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTrackingSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTrackingSettingsClickConfig(true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.this", "id", "click"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.this", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.this", "enable_text", "true"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridTrackingSettingsClickConfig(false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.this", "enabled", "false"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.this", "enable_text", "false"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_click.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridTrackingSettingsOpen(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTrackingSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sendgrid_tracking_settings_open" "this" {
+  enabled = false
+}`,
+				Check: resource.TestCheckResourceAttr("sendgrid_tracking_settings_open.this", "enabled", "false"),
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_open.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridTrackingSettingsSubscription(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTrackingSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sendgrid_tracking_settings_subscription" "this" {
+  enabled       = true
+  html_content  = "<p><% Unsubscribe %></p>"
+  plain_content = "Unsubscribe: <% %>"
+  landing       = "<p>Unsubscribed</p>"
+  replace       = "[unsubscribe]"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.this", "enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_tracking_settings_subscription.this", "html_content", "<p><% Unsubscribe %></p>"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_tracking_settings_subscription.this", "replace", "[unsubscribe]"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.this", "url", ""),
+				),
+			},
+			{
+				Config: `
+resource "sendgrid_tracking_settings_subscription" "this" {
+  enabled = true
+  url     = "https://example.org/unsubscribed"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.this", "html_content", ""),
+					resource.TestCheckResourceAttr(
+						"sendgrid_tracking_settings_subscription.this", "url", "https://example.org/unsubscribed"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_subscription.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridTrackingSettingsGoogleAnalyticsOnBehalfOf(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTrackingSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTrackingSettingsGoogleAnalyticsConfig(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.default", "id",
+						"google_analytics"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.default", "utm_source",
+						"sendgrid"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.subuser", "id", username),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.subuser", "utm_campaign",
+						"subuser"),
+					testAccCheckSendgridTrackingSettingsGoogleAnalyticsCampaign("", ""),
+					testAccCheckSendgridTrackingSettingsGoogleAnalyticsCampaign(username, "subuser"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_google_analytics.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_google_analytics.subuser",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckSendgridTrackingSettingsGoogleAnalyticsCampaign checks that the setting of the account is
+// distinct from the one of the other accounts.
+func testAccCheckSendgridTrackingSettingsGoogleAnalyticsCampaign(onBehalfOf, campaign string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		var setting sendgrid.TrackingSettingGoogleAnalytics
+		if err := c.ReadTrackingSetting(
+			sendgrid.WithOnBehalfOf(context.Background(), onBehalfOf), "google_analytics", &setting,
+		); err.Err != nil {
+			return err.Err
+		}
+
+		if setting.UTMCampaign != campaign {
+			return fmt.Errorf("expected the utm_campaign of %q to be %q, got %q", onBehalfOf, campaign, setting.UTMCampaign)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckSendgridTrackingSettingsDestroy checks that deleting tracking settings resources of the parent
+// account restored the default settings, i.e. only the click and open tracking enabled.
+func testAccCheckSendgridTrackingSettingsDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		name := strings.TrimPrefix(rs.Type, "sendgrid_tracking_settings_")
+		if name == rs.Type || rs.Primary.Attributes["on_behalf_of"] != "" {
+			continue
+		}
+
+		var setting sendgrid.TrackingSettingOpen
+		if err := c.ReadTrackingSetting(context.Background(), name, &setting); err.Err != nil {
+			return err.Err
+		}
+
+		if enabled := name == "click" || name == "open"; setting.Enabled != enabled {
+			return fmt.Errorf("tracking setting %s not restored to its default", name)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridTrackingSettingsClickConfig(enabled, enableText bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_tracking_settings_click" "this" {
+  enabled     = %t
+  enable_text = %t
+}`, enabled, enableText)
+}
+
+func testAccCheckSendgridTrackingSettingsGoogleAnalyticsConfig(username string) string {
+	return testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+		[]string{"127.0.0.1"}) + `
+resource "sendgrid_tracking_settings_google_analytics" "default" {
+  enabled    = true
+  utm_source = "sendgrid"
+  utm_medium = "email"
+}
+
+resource "sendgrid_tracking_settings_google_analytics" "subuser" {
+  on_behalf_of = sendgrid_subuser.this.username
+
+  enabled      = true
+  utm_campaign = "subuser"
+}`
+}
@@ -61,6 +61,7 @@ func resourceSendgridUnsubscribeGroup() *schema.Resource {
 				Description: "The number of unsubscribes that belong to the group.",
 				Computed:    true,
 			},
+			"on_behalf_of": onBehalfOfSchema(),
 		},
 	}
 }
@@ -70,19 +71,15 @@ func resourceSendgridUnsubscribeGroupCreate(
 	d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	isDefault := d.Get("is_default").(bool)
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateUnsubscribeGroup(ctx, name, description, isDefault)
-	})
-
-	group := apiKeyStruct.(*sendgrid.UnsubscribeGroup)
-
-	if err != nil {
-		return diag.FromErr(err)
+	group, err := c.CreateUnsubscribeGroup(ctx, name, description, isDefault)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	d.SetId(fmt.Sprint(group.ID))
@@ -92,14 +89,15 @@ func resourceSendgridUnsubscribeGroupCreate(
 
 func resourceSendgridUnsubscribeGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	group, err := c.ReadUnsubscribeGroup(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return diagFromReadErr(d, err.Err)
 	}
 
 	if err := sendgridUnsubscribeGroupParse(group, d); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -130,16 +128,15 @@ func resourceSendgridUnsubscribeGroupUpdate(
 	d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	isDefault := d.Get("is_default").(bool)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateUnsubscribeGroup(ctx, d.Id(), name, description, isDefault)
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.UpdateUnsubscribeGroup(ctx, d.Id(), name, description, isDefault)
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return resourceSendgridUnsubscribeGroupRead(ctx, d, m)
@@ -150,12 +147,11 @@ func resourceSendgridUnsubscribeGroupDelete(
 	d *schema.ResourceData,
 	m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteUnsubscribeGroup(ctx, d.Id())
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	_, err := c.DeleteUnsubscribeGroup(ctx, d.Id())
+	if err.Err != nil {
+		return diagFromErr(err.Err)
 	}
 
 	return nil
@@ -0,0 +1,194 @@
+/*
+Provide a resource to manage recipients unsubscribed from an unsubscribe group.
+
+**Note** Only the emails listed in the resource are managed: the recipients who unsubscribed from the group
+by themselves are left alone. New emails are added in a single request, removed ones are resubscribed one by one.
+Example Usage
+```hcl
+
+	resource "sendgrid_unsubscribe_group" "newsletter" {
+		name        = "newsletter"
+		description = "The monthly newsletter"
+	}
+
+	resource "sendgrid_unsubscribe_group_suppressions" "newsletter" {
+		group_id = sendgrid_unsubscribe_group.newsletter.id
+		emails   = ["test-inbox@example.com"]
+	}
+
+```
+Import
+The suppressions of an unsubscribe group can be imported by the ID of the group, and the ones of
+a group of a subuser by `<username>/<groupID>`. Every recipient unsubscribed from the group is then managed, e.g.
+```sh
+$ terraform import sendgrid_unsubscribe_group_suppressions.newsletter unsubscribeGroupID
+$ terraform import sendgrid_unsubscribe_group_suppressions.marketing marketing/unsubscribeGroupID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridUnsubscribeGroupSuppressions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridUnsubscribeGroupSuppressionsCreate,
+		ReadContext:   resourceSendgridUnsubscribeGroupSuppressionsRead,
+		UpdateContext: resourceSendgridUnsubscribeGroupSuppressionsUpdate,
+		DeleteContext: resourceSendgridUnsubscribeGroupSuppressionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridUnsubscribeGroupSuppressionsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the unsubscribe group.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"emails": {
+				Type:        schema.TypeSet,
+				Description: "The emails unsubscribed from the group.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"on_behalf_of": onBehalfOfSchema(),
+		},
+	}
+}
+
+// resourceSendgridUnsubscribeGroupSuppressionsImport manages every email suppressed from the group,
+// the only time the full list is read: refreshing only ever looks up the managed emails.
+// The group of a subuser is imported by <username>/<groupID>.
+func resourceSendgridUnsubscribeGroupSuppressionsImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) ([]*schema.ResourceData, error) {
+	c := m.(*sendgrid.Client)
+
+	if username, groupID, ok := strings.Cut(d.Id(), "/"); ok {
+		if username == "" || groupID == "" {
+			return nil, ErrInvalidUnsubscribeGroupSuppressionsImportFormat
+		}
+
+		//nolint:errcheck
+		d.Set("on_behalf_of", username)
+		d.SetId(groupID)
+	}
+
+	emails, err := c.ReadGroupSuppressions(withOnBehalfOf(ctx, d), d.Id())
+	if err.Err != nil {
+		return nil, err.Err
+	}
+
+	//nolint:errcheck
+	d.Set("group_id", d.Id())
+	//nolint:errcheck
+	d.Set("emails", emails)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSendgridUnsubscribeGroupSuppressionsCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	groupID := d.Get("group_id").(string)
+
+	if _, err := c.AddGroupSuppressions(ctx, groupID, stringSetToSlice(d.Get("emails").(*schema.Set))); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(groupID)
+
+	return resourceSendgridUnsubscribeGroupSuppressionsRead(ctx, d, m)
+}
+
+func resourceSendgridUnsubscribeGroupSuppressionsRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	// Only the managed emails are looked up: once they are all removed outside of Terraform, none is left.
+	emails := []string{}
+
+	if managed := stringSetToSlice(d.Get("emails").(*schema.Set)); len(managed) > 0 {
+		var err sendgrid.RequestError
+
+		emails, err = c.SearchGroupSuppressions(ctx, d.Id(), managed)
+		if err.Err != nil {
+			return diagFromReadErr(d, err.Err)
+		}
+	}
+
+	//nolint:errcheck
+	d.Set("group_id", d.Id())
+	//nolint:errcheck
+	d.Set("emails", emails)
+
+	return nil
+}
+
+func resourceSendgridUnsubscribeGroupSuppressionsUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	o, n := d.GetChange("emails")
+	oldEmails := o.(*schema.Set)
+	newEmails := n.(*schema.Set)
+
+	for _, email := range stringSetToSlice(oldEmails.Difference(newEmails)) {
+		if _, err := c.DeleteGroupSuppression(ctx, d.Id(), email); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	if added := stringSetToSlice(newEmails.Difference(oldEmails)); len(added) > 0 {
+		if _, err := c.AddGroupSuppressions(ctx, d.Id(), added); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	return resourceSendgridUnsubscribeGroupSuppressionsRead(ctx, d, m)
+}
+
+func resourceSendgridUnsubscribeGroupSuppressionsDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	ctx = withOnBehalfOf(ctx, d)
+
+	for _, email := range stringSetToSlice(d.Get("emails").(*schema.Set)) {
+		if _, err := c.DeleteGroupSuppression(ctx, d.Id(), email); err.Err != nil {
+			return diagFromErr(err.Err)
+		}
+	}
+
+	return nil
+}
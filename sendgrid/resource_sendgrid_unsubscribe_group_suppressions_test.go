@@ -0,0 +1,160 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridUnsubscribeGroupSuppressionsBasic(t *testing.T) {
+	name := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridUnsubscribeGroupSuppressionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridUnsubscribeGroupSuppressionsConfigBasic(name, "a@example.org", "b@example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_unsubscribe_group_suppressions.this", "group_id", "sendgrid_unsubscribe_group.this", "id"),
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group_suppressions.this", "emails.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridUnsubscribeGroupSuppressionsConfigBasic(name, "b@example.org", "c@example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group_suppressions.this", "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"sendgrid_unsubscribe_group_suppressions.this", "emails.*", "b@example.org"),
+					resource.TestCheckTypeSetElemAttr(
+						"sendgrid_unsubscribe_group_suppressions.this", "emails.*", "c@example.org"),
+					testAccCheckSendgridGroupSuppressions("sendgrid_unsubscribe_group_suppressions.this",
+						"b@example.org", "c@example.org"),
+					testAccCheckSendgridSuppressionsRefreshEmpty("sendgrid_unsubscribe_group_suppressions.this"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_unsubscribe_group_suppressions.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridUnsubscribeGroupSuppressionsImportBySubuser(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridUnsubscribeGroupSuppressionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridUnsubscribeGroupSuppressionsConfigSubuser(username),
+				Check: resource.TestCheckResourceAttr(
+					"sendgrid_unsubscribe_group_suppressions.this", "on_behalf_of", username),
+			},
+			{
+				ResourceName:      "sendgrid_unsubscribe_group_suppressions.this",
+				ImportState:       true,
+				ImportStateIdFunc: testAccSendgridSubuserImportID("sendgrid_unsubscribe_group_suppressions.this"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "sendgrid_unsubscribe_group_suppressions.this",
+				ImportState:   true,
+				ImportStateId: username + "/",
+				ExpectError:   regexp.MustCompile(`invalid import`),
+			},
+		},
+	})
+}
+
+// testAccCheckSendgridGroupSuppressions checks that emails are the only ones unsubscribed from the group of n.
+func testAccCheckSendgridGroupSuppressions(n string, emails ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		got, err := c.ReadGroupSuppressions(context.Background(), rs.Primary.ID)
+		if err.Err != nil {
+			return err.Err
+		}
+
+		if formatResourceList(got) != formatResourceList(emails) {
+			return fmt.Errorf("expected the suppressions of group %s to be %v, got %v", rs.Primary.ID, emails, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridUnsubscribeGroupSuppressionsDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_unsubscribe_group_suppressions" {
+			continue
+		}
+
+		emails, err := c.ReadGroupSuppressions(context.Background(), rs.Primary.ID)
+		if errors.Is(err.Err, sendgrid.ErrNotFound) {
+			continue
+		}
+
+		if err.Err != nil {
+			return err.Err
+		}
+
+		if len(emails) > 0 {
+			return fmt.Errorf("group %s still has suppressions %v", rs.Primary.ID, emails)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridUnsubscribeGroupSuppressionsConfigBasic(name string, emails ...string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_unsubscribe_group" "this" {
+  name        = %q
+  description = "terraform acceptance test"
+}
+
+resource "sendgrid_unsubscribe_group_suppressions" "this" {
+  group_id = sendgrid_unsubscribe_group.this.id
+  emails   = %s
+}`, name, formatResourceList(emails))
+}
+
+func testAccCheckSendgridUnsubscribeGroupSuppressionsConfigSubuser(username string) string {
+	return testAccCheckSendgridSubuserConfigBasic(username, "Passw0rd!"+username, username+"@example.org",
+		[]string{"127.0.0.1"}) + fmt.Sprintf(`
+resource "sendgrid_unsubscribe_group" "this" {
+  on_behalf_of = sendgrid_subuser.this.username
+
+  name        = %q
+  description = "terraform acceptance test"
+}
+
+resource "sendgrid_unsubscribe_group_suppressions" "this" {
+  on_behalf_of = sendgrid_subuser.this.username
+
+  group_id = sendgrid_unsubscribe_group.this.id
+  emails   = ["a@example.org"]
+}`, username)
+}
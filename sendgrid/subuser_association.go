@@ -0,0 +1,133 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+// subuserAssociation describes the sharing of an object of the parent account with a subuser,
+// managed by a resource whose ID is `<object-id>/<username>`.
+// A subuser can only be associated with one object of a kind at a time.
+type subuserAssociation struct {
+	// object is the kind of the shared object, e.g. link branding.
+	object string
+	// idKey is the attribute holding the ID of the shared object, e.g. link_id.
+	idKey string
+	// associate shares the object id with the subuser username.
+	associate func(c *sendgrid.Client, ctx context.Context, id, username string) sendgrid.RequestError
+	// read returns the ID of the object shared with the subuser username.
+	read func(c *sendgrid.Client, ctx context.Context, username string) (string, sendgrid.RequestError)
+	// disassociate stops sharing the object with the subuser username.
+	disassociate func(c *sendgrid.Client, ctx context.Context, username string) sendgrid.RequestError
+	// errImportFormat is returned when importing an ID which isn't `<object-id>/<username>`.
+	errImportFormat error
+}
+
+func resourceSendgridSubuserAssociation(association subuserAssociation) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceSendgridSubuserAssociationCreate(ctx, d, m, association)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceSendgridSubuserAssociationRead(ctx, d, m, association)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return resourceSendgridSubuserAssociationDelete(ctx, d, m, association)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+					return nil, association.errImportFormat
+				}
+
+				//nolint:errcheck
+				d.Set(association.idKey, parts[0])
+				//nolint:errcheck
+				d.Set("username", parts[1])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			association.idKey: {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("The ID of the %s to share.", association.object),
+				Required:    true,
+				ForceNew:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: fmt.Sprintf("The username of the subuser to share the %s with.", association.object),
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridSubuserAssociationCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	association subuserAssociation,
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	id := d.Get(association.idKey).(string)
+	username := d.Get("username").(string)
+
+	if err := association.associate(c, ctx, id, username); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	d.SetId(id + "/" + username)
+
+	return resourceSendgridSubuserAssociationRead(ctx, d, m, association)
+}
+
+func resourceSendgridSubuserAssociationRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	association subuserAssociation,
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	id := d.Get(association.idKey).(string)
+	username := d.Get("username").(string)
+
+	associatedID, err := association.read(c, ctx, username)
+	if err.Err != nil {
+		return diagFromReadErr(d, err.Err)
+	}
+
+	// The subuser may have been associated with another object since.
+	if associatedID != id {
+		return diagFromReadErr(d, fmt.Errorf(
+			"%w: %s %s associated with subuser %s", sendgrid.ErrNotFound, association.object, id, username))
+	}
+
+	return nil
+}
+
+func resourceSendgridSubuserAssociationDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	association subuserAssociation,
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if err := association.disassociate(c, ctx, d.Get("username").(string)); err.Err != nil {
+		return diagFromErr(err.Err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/taharah/terraform-provider-sendgrid/sdk"
+)
+
+const (
+	defaultValidationTimeout      = "10m"
+	defaultValidationPollInterval = "30s"
+
+	// defaultOperationTimeout bounds the create and update of objects waiting for their validation.
+	defaultOperationTimeout = 20 * time.Minute
+)
+
+// validateFunc asks Sendgrid to validate the DNS records of an object.
+// It returns whether the object is valid and the result of each record, keyed by record name.
+type validateFunc func(ctx context.Context) (bool, map[string]sendgrid.ValidationResult, error)
+
+func waitForValidationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Description: "Validate the DNS records after each create and update, " +
+			"until they are all valid or the timeout is reached, which is reported as a warning.",
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timeout": {
+					Type: schema.TypeString,
+					Description: "How long to wait for the DNS records to be valid, as a duration (e.g. `10m`). " +
+						"The wait is also bounded by the `create` and `update` timeouts of the resource.",
+					Optional:     true,
+					Default:      defaultValidationTimeout,
+					ValidateFunc: validateDuration,
+				},
+				"poll_interval": {
+					Type:         schema.TypeString,
+					Description:  "The delay between two validations, as a positive duration (e.g. `30s`).",
+					Optional:     true,
+					Default:      defaultValidationPollInterval,
+					ValidateFunc: validatePositiveDuration,
+				},
+			},
+		},
+	}
+}
+
+// validatePositiveDuration validates a duration greater than 0, like a delay between two calls to the API.
+func validatePositiveDuration(i interface{}, k string) ([]string, []error) {
+	if warnings, errs := validateDuration(i, k); len(errs) > 0 {
+		return warnings, errs
+	}
+
+	if d, _ := time.ParseDuration(i.(string)); d <= 0 {
+		return nil, []error{fmt.Errorf("expected %s to be a positive duration, got %s", k, i)} //nolint:goerr113
+	}
+
+	return nil, nil
+}
+
+// waitForValidationTimeouts are the timeouts of the resources supporting wait_for_validation,
+// so that they can be raised along with the wait.
+func waitForValidationTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultOperationTimeout),
+		Update: schema.DefaultTimeout(defaultOperationTimeout),
+	}
+}
+
+// waitForValidation calls validate until it reports the object as valid, when the wait_for_validation
+// block of d is set, for at most its timeout or operationTimeout, the timeout of the current operation.
+// On timeout, a warning reports the reason each record isn't valid: the object exists, and failing
+// would taint it, replacing the DNS records that were just published.
+func waitForValidation(
+	ctx context.Context,
+	d *schema.ResourceData,
+	operationTimeout time.Duration,
+	object string,
+	validate validateFunc) diag.Diagnostics {
+	blocks := d.Get("wait_for_validation").([]interface{})
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	timeout, pollInterval := defaultValidationTimeout, defaultValidationPollInterval
+
+	// An empty block is read as nil.
+	if block, ok := blocks[0].(map[string]interface{}); ok {
+		timeout = block["timeout"].(string)
+		pollInterval = block["poll_interval"].(string)
+	}
+
+	timeoutDuration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pollIntervalDuration, err := time.ParseDuration(pollInterval)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if operationTimeout < timeoutDuration {
+		timeout, timeoutDuration = operationTimeout.String(), operationTimeout
+	}
+
+	deadline := time.Now().Add(timeoutDuration)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	for {
+		valid, results, err := validate(ctx)
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		if valid {
+			return nil
+		}
+
+		if time.Now().Add(pollIntervalDuration).After(deadline) {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("timed out after %s waiting for the %s to be valid", timeout, object),
+				Detail:   invalidRecords(results),
+			}}
+		}
+
+		select {
+		case <-ctx.Done():
+			return diag.FromErr(ctx.Err())
+		case <-time.After(pollIntervalDuration):
+		}
+	}
+}
+
+// invalidRecords describes why each record of results isn't valid, one per line.
+func invalidRecords(results map[string]sendgrid.ValidationResult) string {
+	lines := make([]string, 0, len(results))
+
+	for record, result := range results {
+		if !result.Valid {
+			lines = append(lines, fmt.Sprintf("%s: %s", record, result.Reason))
+		}
+	}
+
+	sort.Strings(lines)
+
+	return strings.Join(lines, "\n")
+}